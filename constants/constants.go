@@ -42,4 +42,8 @@ const (
 	// 6371008.8 is one published "average radius" see https://en.wikipedia.org/wiki/Earth_radius#Mean_radius, or ftp://athena.fsv.cvut.cz/ZFG/grs80-Moritz.pdf p.4
 	// https://github.com/Turfjs/turf/issues/635
 	EarthRadius = 6371008.8
+	// WGS84SemiMajorAxis is the equatorial radius of the WGS84 ellipsoid in meters. https://en.wikipedia.org/wiki/World_Geodetic_System#WGS84
+	WGS84SemiMajorAxis = 6378137.0
+	// WGS84Flattening is the flattening of the WGS84 ellipsoid, the semi-minor axis is WGS84SemiMajorAxis * (1 - WGS84Flattening)
+	WGS84Flattening = 1 / 298.257223563
 )
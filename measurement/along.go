@@ -0,0 +1,247 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// AlongWithOptions takes a line and returns a point at a specified distance along its great circle or rhumb line segments.
+func AlongWithOptions(t interface{}, distance float64, options *LineOptions) (*geometry.Point, error) {
+	options = lineDefaults(options)
+	parts, err := linePositions(t)
+	if err != nil {
+		return nil, err
+	}
+	pos, err := alongPosition(parts, distance, options)
+	if err != nil {
+		return nil, err
+	}
+	return &geometry.Point{Lng: pos[0], Lat: pos[1]}, nil
+}
+
+// AlongFeature takes a line and returns the point at a specified distance along the line as a Point Feature.
+// When the vertices of the line have an altitude, the altitude of the point is interpolated between them and added
+// as the third coordinate.
+func AlongFeature(t interface{}, distance float64, options *LineOptions) (*feature.Feature, error) {
+	options = lineDefaults(options)
+	parts, err := linePositions(t)
+	if err != nil {
+		return nil, err
+	}
+	pos, err := alongPosition(parts, distance, options)
+	if err != nil {
+		return nil, err
+	}
+	g := geometry.Geometry{
+		GeoJSONType: geojson.Point,
+		Coordinates: pos,
+	}
+	return feature.New(g, []float64{pos[0], pos[1], pos[0], pos[1]}, lineProperties(t), "")
+}
+
+// LineSliceAlong takes a line and returns the part of it between the start and the stop distances as a LineString
+// Feature, the altitude of the cut points is interpolated.
+// A slice that spans several parts of a MultiLineString is returned as a MultiLineString Feature.
+// ref. http://turfjs.org/docs/#lineSliceAlong
+func LineSliceAlong(t interface{}, startDist float64, stopDist float64, units string) (*feature.Feature, error) {
+	options := lineDefaults(&LineOptions{Units: units})
+	parts, err := linePositions(t)
+	if err != nil {
+		return nil, err
+	}
+	if startDist < 0 {
+		startDist = 0
+	}
+	if stopDist <= startDist {
+		return nil, errors.New("stop distance must be greater than start distance")
+	}
+
+	pieces := [][][]float64{}
+	travelled := 0.0
+	done := false
+	for _, part := range parts {
+		if done {
+			break
+		}
+		var current [][]float64
+		if len(part) > 0 && travelled >= startDist && travelled < stopDist {
+			current = [][]float64{copyPosition(part[0])}
+		}
+		for i := 1; i < len(part); i++ {
+			d, err := segmentDistance(positionToPoint(part[i-1]), positionToPoint(part[i]), options)
+			if err != nil {
+				return nil, err
+			}
+			if current == nil && startDist >= travelled && startDist < travelled+d {
+				p, err := interpolatePosition(part[i-1], part[i], startDist-travelled, d, options)
+				if err != nil {
+					return nil, err
+				}
+				current = [][]float64{p}
+			}
+			if current != nil {
+				if stopDist <= travelled+d {
+					p, err := interpolatePosition(part[i-1], part[i], stopDist-travelled, d, options)
+					if err != nil {
+						return nil, err
+					}
+					current = append(current, p)
+					done = true
+					break
+				}
+				current = append(current, copyPosition(part[i]))
+			}
+			travelled += d
+		}
+		if len(current) > 1 {
+			pieces = append(pieces, current)
+		}
+	}
+	if len(pieces) == 0 {
+		return nil, errors.New("start distance is beyond the end of the line")
+	}
+
+	points := []geometry.Point{}
+	for _, piece := range pieces {
+		for _, p := range piece {
+			points = append(points, positionToPoint(p))
+		}
+	}
+	var g geometry.Geometry
+	if len(pieces) == 1 {
+		g = geometry.Geometry{
+			GeoJSONType: geojson.LineString,
+			Coordinates: pieces[0],
+		}
+	} else {
+		g = geometry.Geometry{
+			GeoJSONType: geojson.MultiLineString,
+			Coordinates: pieces,
+		}
+	}
+	return feature.New(g, bboxCalculator(points), lineProperties(t), "")
+}
+
+// alongPosition returns the position at the given distance along the parts of a line.
+func alongPosition(parts [][][]float64, distance float64, options *LineOptions) ([]float64, error) {
+	if len(parts) == 0 || len(parts[0]) == 0 {
+		return nil, errors.New("line is required")
+	}
+	if distance <= 0 {
+		return copyPosition(parts[0][0]), nil
+	}
+	travelled := 0.0
+	for _, part := range parts {
+		for i := 1; i < len(part); i++ {
+			d, err := segmentDistance(positionToPoint(part[i-1]), positionToPoint(part[i]), options)
+			if err != nil {
+				return nil, err
+			}
+			if d > 0 && travelled+d >= distance {
+				return interpolatePosition(part[i-1], part[i], distance-travelled, d, options)
+			}
+			travelled += d
+		}
+	}
+	last := parts[len(parts)-1]
+	return copyPosition(last[len(last)-1]), nil
+}
+
+// interpolatePosition returns the position at offset from a towards b, d is the length of the segment.
+// The altitude is interpolated linearly when both positions have one.
+func interpolatePosition(a []float64, b []float64, offset float64, d float64, options *LineOptions) ([]float64, error) {
+	if offset <= 0 || d == 0 {
+		return copyPosition(a), nil
+	}
+	if offset >= d {
+		return copyPosition(b), nil
+	}
+	p, err := segmentDestination(positionToPoint(a), positionToPoint(b), offset, options)
+	if err != nil {
+		return nil, err
+	}
+	pos := []float64{p.Lng, p.Lat}
+	if len(a) > 2 && len(b) > 2 {
+		pos = append(pos, a[2]+(b[2]-a[2])*offset/d)
+	}
+	return pos, nil
+}
+
+// linePositions returns the raw positions of the parts of a LineString or MultiLineString, Geometry or Feature.
+// The positions of a Geometry or Feature keep their altitude.
+func linePositions(t interface{}) ([][][]float64, error) {
+	switch gtp := t.(type) {
+	case geometry.LineString:
+		return [][][]float64{pointsToCoords(gtp.Coordinates)}, nil
+	case *geometry.LineString:
+		return [][][]float64{pointsToCoords(gtp.Coordinates)}, nil
+	case geometry.MultiLineString:
+		return multiLinePositions(gtp.Coordinates), nil
+	case *geometry.MultiLineString:
+		return multiLinePositions(gtp.Coordinates), nil
+	case geometry.Geometry:
+		return geometryLinePositions(gtp)
+	case *geometry.Geometry:
+		return geometryLinePositions(*gtp)
+	case feature.Feature:
+		return geometryLinePositions(gtp.Geometry)
+	case *feature.Feature:
+		return geometryLinePositions(gtp.Geometry)
+	}
+	return nil, errors.New("unknown line type")
+}
+
+func multiLinePositions(lines []geometry.LineString) [][][]float64 {
+	parts := [][][]float64{}
+	for _, l := range lines {
+		parts = append(parts, pointsToCoords(l.Coordinates))
+	}
+	return parts
+}
+
+func geometryLinePositions(g geometry.Geometry) ([][][]float64, error) {
+	data, err := json.Marshal(g.Coordinates)
+	if err != nil {
+		return nil, errors.New("cannot marshal object")
+	}
+	switch g.GeoJSONType {
+	case geojson.LineString:
+		var coords [][]float64
+		if err := json.Unmarshal(data, &coords); err != nil {
+			return nil, errors.New("cannot unmarshal object")
+		}
+		return [][][]float64{coords}, nil
+	case geojson.MultiLineString:
+		var coords [][][]float64
+		if err := json.Unmarshal(data, &coords); err != nil {
+			return nil, errors.New("cannot unmarshal object")
+		}
+		return coords, nil
+	}
+	return nil, errors.New("geometry must be a LineString or a MultiLineString")
+}
+
+// lineProperties returns a copy of the properties of a Feature, other objects have no properties.
+func lineProperties(t interface{}) map[string]interface{} {
+	switch gtp := t.(type) {
+	case feature.Feature:
+		return copyProperties(gtp.Properties)
+	case *feature.Feature:
+		return copyProperties(gtp.Properties)
+	}
+	return map[string]interface{}{}
+}
+
+func positionToPoint(p []float64) geometry.Point {
+	return geometry.Point{Lng: p[0], Lat: p[1]}
+}
+
+func copyPosition(p []float64) []float64 {
+	c := make([]float64, len(p))
+	copy(c, p)
+	return c
+}
@@ -0,0 +1,125 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestAlongMultiLineString(t *testing.T) {
+	ml := geometry.MultiLineString{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 5, Lat: 0}, {Lng: 6, Lat: 0}}},
+	}}
+	degree, err := PointDistance(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 1, Lat: 0}, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("PointDistance error %v", err)
+		return
+	}
+
+	// the gap between the parts is not walked
+	p, err := Along(ml, degree*1.5, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Along error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(p.Lng-5.5) < 1e-9 && math.Abs(p.Lat) < 1e-9)
+
+	p, err = Along(ml, degree*10, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Along error %v", err)
+		return
+	}
+	assert.Equal(t, *p, geometry.Point{Lng: 6, Lat: 0})
+}
+
+func TestAlongFeatureAltitude(t *testing.T) {
+	f, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: [][]float64{{0, 0, 100}, {1, 0, 200}, {2, 0, 100}},
+	}, nil, map[string]interface{}{"name": "ridge"}, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+	degree, err := PointDistance(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 1, Lat: 0}, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("PointDistance error %v", err)
+		return
+	}
+
+	p, err := AlongFeature(f, degree*0.25, &LineOptions{Units: constants.UnitKilometers})
+	if err != nil {
+		t.Errorf("AlongFeature error %v", err)
+		return
+	}
+	c := p.Geometry.Coordinates.([]float64)
+	assert.Equal(t, len(c), 3)
+	assert.True(t, math.Abs(c[0]-0.25) < 1e-9)
+	assert.True(t, math.Abs(c[2]-125) < 1e-6)
+	assert.Equal(t, p.Properties["name"], "ridge")
+
+	p, err = AlongFeature(f, degree*1.5, &LineOptions{Units: constants.UnitKilometers})
+	if err != nil {
+		t.Errorf("AlongFeature error %v", err)
+		return
+	}
+	c = p.Geometry.Coordinates.([]float64)
+	assert.True(t, math.Abs(c[2]-150) < 1e-6)
+}
+
+func TestLineSliceAlong(t *testing.T) {
+	f, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: [][]float64{{0, 0, 0}, {1, 0, 100}, {2, 0, 300}, {3, 0, 300}},
+	}, nil, nil, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+	degree, err := PointDistance(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 1, Lat: 0}, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("PointDistance error %v", err)
+		return
+	}
+
+	s, err := LineSliceAlong(f, degree*0.5, degree*1.5, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineSliceAlong error %v", err)
+		return
+	}
+	assert.Equal(t, s.Geometry.GeoJSONType, geojson.LineString)
+	coords := s.Geometry.Coordinates.([][]float64)
+	assert.Equal(t, len(coords), 3)
+	assert.True(t, math.Abs(coords[0][0]-0.5) < 1e-9 && math.Abs(coords[0][2]-50) < 1e-6)
+	assert.Equal(t, coords[1], []float64{1, 0, 100})
+	assert.True(t, math.Abs(coords[2][0]-1.5) < 1e-9 && math.Abs(coords[2][2]-200) < 1e-6)
+
+	// the stop distance is clamped to the end of the line
+	s, err = LineSliceAlong(f, degree*2.5, degree*10, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineSliceAlong error %v", err)
+		return
+	}
+	coords = s.Geometry.Coordinates.([][]float64)
+	assert.Equal(t, coords[len(coords)-1], []float64{3, 0, 300})
+
+	_, err = LineSliceAlong(f, degree*10, degree*11, constants.UnitKilometers)
+	assert.True(t, err != nil)
+
+	ml := geometry.MultiLineString{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 5, Lat: 0}, {Lng: 6, Lat: 0}}},
+	}}
+	s, err = LineSliceAlong(ml, degree*0.5, degree*1.5, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineSliceAlong error %v", err)
+		return
+	}
+	assert.Equal(t, s.Geometry.GeoJSONType, geojson.MultiLineString)
+}
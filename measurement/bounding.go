@@ -0,0 +1,148 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+	meta "github.com/et-soft/turf-go/meta/coordAll"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// BoundingOptions contains the options of MinimumBoundingRectangle and MinimumEnclosingCircle.
+type BoundingOptions struct {
+	// Units of the width, height and radius properties. constants.UnitDefault is the default value
+	Units string
+	// Steps is the number of vertices of the circle. 64 is the default value
+	Steps int
+	// Properties of the returned Feature, the calculated properties are added to them
+	Properties map[string]interface{}
+}
+
+// MinimumBoundingRectangle takes any GeoJSON object and returns the oriented rectangle of minimum area that
+// encloses all its vertices as a Polygon Feature.
+// The Feature has the properties "width" (the longer side), "height" and "angle", the bearing of the width side in
+// degrees from True North between 0 and 180.
+// The rectangle is calculated on a local plane, it is meant for feature-sized extents.
+func MinimumBoundingRectangle(t interface{}, options *BoundingOptions) (*feature.Feature, error) {
+	options = boundingDefaults(options)
+	points, err := allCoords(t)
+	if err != nil {
+		return nil, err
+	}
+
+	lp := newLocalPlane(points)
+	projected := []xy{}
+	for _, p := range points {
+		projected = append(projected, lp.forward(p))
+	}
+	rect := minAreaRectangle(convexHull(projected))
+
+	corners := rect.corners
+	direction := rect.direction
+	width := rect.width
+	height := rect.height
+	if height > width {
+		width, height = height, width
+		direction = xy{-direction.y, direction.x}
+		corners = [4]xy{corners[1], corners[2], corners[3], corners[0]}
+	}
+
+	ring := []geometry.Point{}
+	for _, c := range corners {
+		ring = append(ring, lp.inverse(c))
+	}
+	ring = append(ring, ring[0])
+
+	w, err := conversions.ConvertLength(width, constants.UnitMeters, options.Units)
+	if err != nil {
+		return nil, err
+	}
+	h, err := conversions.ConvertLength(height, constants.UnitMeters, options.Units)
+	if err != nil {
+		return nil, err
+	}
+	angle := math.Mod(conversions.RadiansToDegrees(math.Atan2(direction.x, direction.y))+360, 180)
+
+	props := copyProperties(options.Properties)
+	props["width"] = w
+	props["height"] = h
+	props["angle"] = angle
+	return polygonFeature(ring, props)
+}
+
+// MinimumEnclosingCircle takes any GeoJSON object and returns the smallest circle that encloses all its vertices
+// as a Polygon Feature with the "radius" property.
+// The circle is calculated on a local plane, it is meant for feature-sized extents.
+func MinimumEnclosingCircle(t interface{}, options *BoundingOptions) (*feature.Feature, error) {
+	options = boundingDefaults(options)
+	points, err := allCoords(t)
+	if err != nil {
+		return nil, err
+	}
+
+	lp := newLocalPlane(points)
+	projected := []xy{}
+	for _, p := range points {
+		projected = append(projected, lp.forward(p))
+	}
+	c := minEnclosingCircle(convexHull(projected))
+
+	ring := ellipseRing(lp, c.center, c.radius, c.radius, xy{1, 0}, options.Steps)
+
+	r, err := conversions.ConvertLength(c.radius, constants.UnitMeters, options.Units)
+	if err != nil {
+		return nil, err
+	}
+	center := lp.inverse(c.center)
+
+	props := copyProperties(options.Properties)
+	props["radius"] = r
+	props["center"] = []float64{center.Lng, center.Lat}
+	return polygonFeature(ring, props)
+}
+
+func boundingDefaults(options *BoundingOptions) *BoundingOptions {
+	o := BoundingOptions{Units: constants.UnitDefault, Steps: 64}
+	if options != nil {
+		if options.Units != "" {
+			o.Units = options.Units
+		}
+		if options.Steps > 2 {
+			o.Steps = options.Steps
+		}
+		o.Properties = options.Properties
+	}
+	return &o
+}
+
+func allCoords(t interface{}) ([]geometry.Point, error) {
+	excludeWrapCoord := true
+	points, err := meta.CoordAll(t, &excludeWrapCoord)
+	if err != nil {
+		return nil, err
+	}
+	if len(points) == 0 {
+		return nil, errors.New("no coordinates found")
+	}
+	return points, nil
+}
+
+func copyProperties(properties map[string]interface{}) map[string]interface{} {
+	props := map[string]interface{}{}
+	for k, v := range properties {
+		props[k] = v
+	}
+	return props
+}
+
+func polygonFeature(ring []geometry.Point, properties map[string]interface{}) (*feature.Feature, error) {
+	g := geometry.Geometry{
+		GeoJSONType: geojson.Polygon,
+		Coordinates: [][][]float64{pointsToCoords(ring)},
+	}
+	return feature.New(g, bboxCalculator(ring), properties, "")
+}
@@ -0,0 +1,100 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestMinimumBoundingRectangle(t *testing.T) {
+	// a 4x1 rectangle rotated by 30 degrees with a notch on its long side
+	θ := math.Pi / 6
+	u := []float64{0.04 * math.Cos(θ), 0.04 * math.Sin(θ)}
+	v := []float64{-0.01 * math.Sin(θ), 0.01 * math.Cos(θ)}
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{ring(
+		[]float64{0, 0},
+		[]float64{u[0], u[1]},
+		[]float64{u[0] + v[0], u[1] + v[1]},
+		[]float64{u[0]/2 + v[0]/2, u[1]/2 + v[1]/2},
+		[]float64{v[0], v[1]},
+		[]float64{0, 0},
+	)}}
+
+	f, err := MinimumBoundingRectangle(&poly, &BoundingOptions{Units: constants.UnitMeters, Properties: map[string]interface{}{"name": "parcel"}})
+	if err != nil {
+		t.Errorf("MinimumBoundingRectangle error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.GeoJSONType, geojson.Polygon)
+	assert.Equal(t, f.Properties["name"], "parcel")
+
+	degree := constants.EarthRadius * math.Pi / 180
+	assert.True(t, math.Abs(f.Properties["width"].(float64)-0.04*degree) < 1)
+	assert.True(t, math.Abs(f.Properties["height"].(float64)-0.01*degree) < 1)
+	assert.True(t, math.Abs(f.Properties["angle"].(float64)-60) < 1e-2)
+
+	rect, err := f.ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error %v", err)
+		return
+	}
+	assert.Equal(t, len(rect.Coordinates[0].Coordinates), 5)
+	a, err := Area(rect)
+	if err != nil {
+		t.Errorf("Area error %v", err)
+	}
+	assert.True(t, math.Abs(a-0.04*0.01*degree*degree)/a < 1e-3)
+}
+
+func TestMinimumEnclosingCircle(t *testing.T) {
+	fc, err := feature.CollectionFromJSON(`{"type": "FeatureCollection", "features": [
+		{"type": "Feature", "properties": {}, "geometry": {"type": "Point", "coordinates": [0, 0]}},
+		{"type": "Feature", "properties": {}, "geometry": {"type": "Point", "coordinates": [0.01, 0]}},
+		{"type": "Feature", "properties": {}, "geometry": {"type": "Point", "coordinates": [0.01, 0.01]}},
+		{"type": "Feature", "properties": {}, "geometry": {"type": "Point", "coordinates": [0.005, 0.005]}},
+		{"type": "Feature", "properties": {}, "geometry": {"type": "Point", "coordinates": [0, 0.01]}}
+	]}`)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+		return
+	}
+
+	f, err := MinimumEnclosingCircle(fc, &BoundingOptions{Units: constants.UnitMeters, Steps: 32})
+	if err != nil {
+		t.Errorf("MinimumEnclosingCircle error %v", err)
+		return
+	}
+	degree := constants.EarthRadius * math.Pi / 180
+	assert.True(t, math.Abs(f.Properties["radius"].(float64)-0.01*degree*math.Sqrt2/2) < 1)
+	center := f.Properties["center"].([]float64)
+	assert.True(t, math.Abs(center[0]-0.005) < 1e-9 && math.Abs(center[1]-0.005) < 1e-9)
+
+	circle, err := f.ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error %v", err)
+		return
+	}
+	assert.Equal(t, len(circle.Coordinates[0].Coordinates), 33)
+}
+
+func TestMinimumEnclosingCircleTwoPoints(t *testing.T) {
+	mp := geometry.MultiPoint{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0, Lat: 0.02}}}
+
+	f, err := MinimumEnclosingCircle(&mp, nil)
+	if err != nil {
+		t.Errorf("MinimumEnclosingCircle error %v", err)
+		return
+	}
+	degree := constants.EarthRadius * math.Pi / 180 / 1000
+	assert.True(t, math.Abs(f.Properties["radius"].(float64)-0.01*degree) < 1e-6)
+
+	_, err = MinimumEnclosingCircle(&geometry.MultiPoint{}, nil)
+	if err == nil {
+		t.Errorf("expected an error without coordinates")
+	}
+}
@@ -0,0 +1,171 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// CrossTrackDistance returns the distance of a point from the great circle path through start and end.
+// The distance is positive when the point is on the right of the path and negative when it is on the left.
+// ref. http://www.movable-type.co.uk/scripts/latlong.html#cross-track
+func CrossTrackDistance(pt geometry.Point, start geometry.Point, end geometry.Point, units string) (float64, error) {
+	δxt, _, err := trackDistances(pt, start, end)
+	if err != nil {
+		return 0.0, err
+	}
+	return conversions.RadiansToLength(δxt, units)
+}
+
+// AlongTrackDistance returns the distance from start to the point of the great circle path through start and end
+// closest to pt. The distance is negative when that point is behind start.
+// ref. http://www.movable-type.co.uk/scripts/latlong.html#along-track
+func AlongTrackDistance(pt geometry.Point, start geometry.Point, end geometry.Point, units string) (float64, error) {
+	_, δat, err := trackDistances(pt, start, end)
+	if err != nil {
+		return 0.0, err
+	}
+	return conversions.RadiansToLength(δat, units)
+}
+
+// BearingIntersection returns the intersection of two great circle paths, each one defined by a start point and an
+// initial bearing in decimal degrees.
+// The paths cross twice on opposite sides of the Earth, the intersection ahead of both start points is returned.
+// It returns an error when the paths diverge and neither intersection is ahead of both start points.
+// ref. http://www.movable-type.co.uk/scripts/latlong-vectors.html#intersection
+func BearingIntersection(p1 geometry.Point, bearing1 float64, p2 geometry.Point, bearing2 float64) (*geometry.Point, error) {
+	if p1 == p2 {
+		return &geometry.Point{Lat: p1.Lat, Lng: p1.Lng}, nil
+	}
+	v1 := toVector(p1)
+	v2 := toVector(p2)
+	c1 := greatCircleNormal(p1, bearing1)
+	c2 := greatCircleNormal(p2, bearing2)
+
+	i := c1.cross(c2)
+	if i.norm() < 1e-12 {
+		return nil, errors.New("paths are on the same great circle")
+	}
+
+	// the direction of travel of each path tells which of the two intersections is ahead
+	dir1 := math.Copysign(1, c1.cross(v1).dot(i))
+	dir2 := math.Copysign(1, c2.cross(v2).dot(i))
+	switch dir1 + dir2 {
+	case -2:
+		i = i.scale(-1)
+	case 0:
+		// every intersection is ahead of a path and behind the other one
+		return nil, errors.New("paths diverge, no intersection is ahead of both start points")
+	}
+	p := i.toPoint()
+	return &p, nil
+}
+
+// SegmentIntersection returns the intersection of the great circle segments start1-end1 and start2-end2.
+// When the segments don't cross it returns a nil point and a nil error, the result must be checked before use.
+// It returns an error for a degenerate segment or segments on the same great circle.
+func SegmentIntersection(start1 geometry.Point, end1 geometry.Point, start2 geometry.Point, end2 geometry.Point) (*geometry.Point, error) {
+	a1 := toVector(start1)
+	a2 := toVector(end1)
+	b1 := toVector(start2)
+	b2 := toVector(end2)
+	c1 := a1.cross(a2)
+	c2 := b1.cross(b2)
+	if c1.norm() < 1e-12 || c2.norm() < 1e-12 {
+		return nil, errors.New("segment is degenerate or antipodal")
+	}
+
+	i := c1.cross(c2)
+	if i.norm() < 1e-12 {
+		return nil, errors.New("segments are on the same great circle")
+	}
+	if a1.add(a2).add(b1).add(b2).dot(i) < 0 {
+		i = i.scale(-1)
+	}
+	if !onArc(i, a1, a2, c1) || !onArc(i, b1, b2, c2) {
+		return nil, nil
+	}
+	p := i.toPoint()
+	return &p, nil
+}
+
+// trackDistances returns the cross-track and the along-track angular distances of pt from the path start-end.
+func trackDistances(pt geometry.Point, start geometry.Point, end geometry.Point) (float64, float64, error) {
+	if start == end {
+		return 0.0, 0.0, errors.New("start and end must be different points")
+	}
+	δ13, err := Distance(start.Lng, start.Lat, pt.Lng, pt.Lat, constants.UnitRadians)
+	if err != nil {
+		return 0.0, 0.0, err
+	}
+	θ13 := conversions.DegreesToRadians(Bearing(start.Lng, start.Lat, pt.Lng, pt.Lat))
+	θ12 := conversions.DegreesToRadians(Bearing(start.Lng, start.Lat, end.Lng, end.Lat))
+
+	δxt := math.Asin(math.Sin(δ13) * math.Sin(θ13-θ12))
+	δat := math.Acos(math.Max(-1, math.Min(1, math.Cos(δ13)/math.Cos(δxt))))
+	if math.Cos(θ12-θ13) < 0 {
+		δat = -δat
+	}
+	return δxt, δat, nil
+}
+
+// vector is an n-vector, the unit vector normal to the Earth surface at a point.
+type vector struct {
+	x float64
+	y float64
+	z float64
+}
+
+func toVector(p geometry.Point) vector {
+	φ := conversions.DegreesToRadians(p.Lat)
+	λ := conversions.DegreesToRadians(p.Lng)
+	return vector{math.Cos(φ) * math.Cos(λ), math.Cos(φ) * math.Sin(λ), math.Sin(φ)}
+}
+
+func (v vector) toPoint() geometry.Point {
+	return geometry.Point{
+		Lat: conversions.RadiansToDegrees(math.Atan2(v.z, math.Hypot(v.x, v.y))),
+		Lng: conversions.RadiansToDegrees(math.Atan2(v.y, v.x)),
+	}
+}
+
+func (v vector) add(w vector) vector {
+	return vector{v.x + w.x, v.y + w.y, v.z + w.z}
+}
+
+func (v vector) scale(k float64) vector {
+	return vector{v.x * k, v.y * k, v.z * k}
+}
+
+func (v vector) dot(w vector) float64 {
+	return v.x*w.x + v.y*w.y + v.z*w.z
+}
+
+func (v vector) cross(w vector) vector {
+	return vector{v.y*w.z - v.z*w.y, v.z*w.x - v.x*w.z, v.x*w.y - v.y*w.x}
+}
+
+func (v vector) norm() float64 {
+	return math.Sqrt(v.dot(v))
+}
+
+// greatCircleNormal returns the normal of the great circle through p heading to the bearing.
+func greatCircleNormal(p geometry.Point, bearing float64) vector {
+	φ := conversions.DegreesToRadians(p.Lat)
+	λ := conversions.DegreesToRadians(p.Lng)
+	θ := conversions.DegreesToRadians(bearing)
+	return vector{
+		math.Sin(λ)*math.Cos(θ) - math.Sin(φ)*math.Cos(λ)*math.Sin(θ),
+		-math.Cos(λ)*math.Cos(θ) - math.Sin(φ)*math.Sin(λ)*math.Sin(θ),
+		math.Cos(φ) * math.Sin(θ),
+	}
+}
+
+// onArc reports whether v lies on the minor arc from a to b of the great circle with normal n = a×b.
+func onArc(v vector, a vector, b vector, n vector) bool {
+	const ε = 1e-12
+	return a.cross(v).dot(n) >= -ε && v.cross(b).dot(n) >= -ε
+}
@@ -0,0 +1,111 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestCrossTrackDistance(t *testing.T) {
+	pt := geometry.Point{Lat: 53.2611, Lng: -0.7972}
+	start := geometry.Point{Lat: 53.3206, Lng: -1.7297}
+	end := geometry.Point{Lat: 53.1887, Lng: 0.1334}
+
+	d, err := CrossTrackDistance(pt, start, end, constants.UnitMeters)
+	if err != nil {
+		t.Errorf("CrossTrackDistance error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(d-(-307.5)) < 0.5)
+
+	// the same point is on the right of the reversed path
+	d, err = CrossTrackDistance(pt, end, start, constants.UnitMeters)
+	if err != nil {
+		t.Errorf("CrossTrackDistance error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(d-307.5) < 0.5)
+
+	_, err = CrossTrackDistance(pt, start, start, constants.UnitMeters)
+	assert.True(t, err != nil)
+}
+
+func TestAlongTrackDistance(t *testing.T) {
+	pt := geometry.Point{Lat: 53.2611, Lng: -0.7972}
+	start := geometry.Point{Lat: 53.3206, Lng: -1.7297}
+	end := geometry.Point{Lat: 53.1887, Lng: 0.1334}
+
+	d, err := AlongTrackDistance(pt, start, end, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("AlongTrackDistance error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(d-62.331) < 0.01)
+
+	// a point behind the start
+	d, err = AlongTrackDistance(geometry.Point{Lat: 0, Lng: -1}, geometry.Point{Lat: 0, Lng: 0}, geometry.Point{Lat: 0, Lng: 1}, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("AlongTrackDistance error %v", err)
+		return
+	}
+	assert.True(t, d < 0)
+}
+
+func TestBearingIntersection(t *testing.T) {
+	p1 := geometry.Point{Lat: 51.8853, Lng: 0.2545}
+	p2 := geometry.Point{Lat: 49.0034, Lng: 2.5735}
+
+	i, err := BearingIntersection(p1, 108.547, p2, 32.435)
+	if err != nil {
+		t.Errorf("BearingIntersection error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(i.Lat-50.9078) < 1e-3)
+	assert.True(t, math.Abs(i.Lng-4.5084) < 1e-3)
+
+	_, err = BearingIntersection(geometry.Point{Lat: 0, Lng: 0}, 90, geometry.Point{Lat: 0, Lng: 10}, 90)
+	assert.True(t, err != nil)
+
+	// the meridian crosses the equator ahead of the eastward path but behind the northward one
+	_, err = BearingIntersection(geometry.Point{Lat: 0, Lng: 0}, 90, geometry.Point{Lat: 1, Lng: 5}, 0)
+	assert.True(t, err != nil)
+}
+
+func TestSegmentIntersection(t *testing.T) {
+	i, err := SegmentIntersection(
+		geometry.Point{Lat: -1, Lng: 0}, geometry.Point{Lat: 1, Lng: 0},
+		geometry.Point{Lat: 0, Lng: -1}, geometry.Point{Lat: 0, Lng: 1},
+	)
+	if err != nil {
+		t.Errorf("SegmentIntersection error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(i.Lat) < 1e-9 && math.Abs(i.Lng) < 1e-9)
+
+	// the great circles cross but the segments don't
+	i, err = SegmentIntersection(
+		geometry.Point{Lat: 1, Lng: 0}, geometry.Point{Lat: 2, Lng: 0},
+		geometry.Point{Lat: 0, Lng: -1}, geometry.Point{Lat: 0, Lng: 1},
+	)
+	if err != nil {
+		t.Errorf("SegmentIntersection error %v", err)
+		return
+	}
+	assert.True(t, i == nil)
+
+	// the great circles cross beyond the end of the second segment
+	i, err = SegmentIntersection(
+		geometry.Point{Lat: -1, Lng: 10}, geometry.Point{Lat: 1, Lng: 10},
+		geometry.Point{Lat: 0, Lng: -1}, geometry.Point{Lat: 0, Lng: 1},
+	)
+	assert.True(t, i == nil && err == nil)
+
+	_, err = SegmentIntersection(
+		geometry.Point{Lat: 0, Lng: 0}, geometry.Point{Lat: 0, Lng: 0},
+		geometry.Point{Lat: 0, Lng: -1}, geometry.Point{Lat: 0, Lng: 1},
+	)
+	assert.True(t, err != nil)
+}
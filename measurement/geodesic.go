@@ -0,0 +1,310 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// EarthModel is the shape of the earth used by the geodesic calculations.
+type EarthModel string
+
+const (
+	// Sphere is the sphere of constants.EarthRadius, the model used by Distance, Bearing and Destination.
+	Sphere EarthModel = "Sphere"
+	// WGS84 is the WGS84 ellipsoid.
+	WGS84 EarthModel = "WGS84"
+)
+
+// GeodesicOptions contains the options of the geodesic calculations.
+type GeodesicOptions struct {
+	// Model is the earth model. WGS84 is the default value
+	Model EarthModel
+	// Units is the unit of the distances. constants.UnitDefault is the default value
+	Units string
+}
+
+const (
+	vincentyTolerance     = 1e-12
+	vincentyMaxIterations = 200
+	// newton iterations used when Vincenty's inverse formula doesn't converge (near-antipodal points)
+	geodesicMaxIterations = 100
+	// tolerance of the newton iterations in meters
+	geodesicTolerance = 1e-6
+)
+
+var (
+	errVincentyConvergence = errors.New("vincenty formula failed to converge")
+	errGeodesicConvergence = errors.New("geodesic inverse problem failed to converge")
+)
+
+// GeodesicDistance calculates the distance between two points.
+// On the WGS84 ellipsoid the Vincenty inverse formula is used, near-antipodal points where it fails to converge
+// are solved iteratively from the direct problem and antipodal points along a meridian.
+func GeodesicDistance(p1 geometry.Point, p2 geometry.Point, options *GeodesicOptions) (float64, error) {
+	options = geodesicDefaults(options)
+	if options.Model == Sphere {
+		return PointDistance(p1, p2, options.Units)
+	}
+	if options.Model != WGS84 {
+		return 0.0, errors.New("invalid earth model")
+	}
+
+	s, _, _, err := geodesicInverse(p1, p2)
+	if err != nil {
+		return 0.0, err
+	}
+	return conversions.ConvertLength(s, constants.UnitMeters, options.Units)
+}
+
+// GeodesicBearing finds the initial bearing from p1 to p2 in degrees from True North (0 to 360).
+func GeodesicBearing(p1 geometry.Point, p2 geometry.Point, options *GeodesicOptions) (float64, error) {
+	options = geodesicDefaults(options)
+	if options.Model == Sphere {
+		return PointBearing(p1, p2), nil
+	}
+	if options.Model != WGS84 {
+		return 0.0, errors.New("invalid earth model")
+	}
+
+	_, α1, _, err := geodesicInverse(p1, p2)
+	if err != nil {
+		return 0.0, err
+	}
+	return normalizeBearing(conversions.RadiansToDegrees(α1)), nil
+}
+
+// GeodesicDestination returns the destination point having travelled the given distance from p1 along the geodesic
+// with the given initial bearing in degrees from True North.
+func GeodesicDestination(p1 geometry.Point, distance float64, bearing float64, options *GeodesicOptions) (*geometry.Point, error) {
+	options = geodesicDefaults(options)
+	if options.Model == Sphere {
+		return Destination(p1, distance, bearing, options.Units)
+	}
+	if options.Model != WGS84 {
+		return nil, errors.New("invalid earth model")
+	}
+
+	s, err := conversions.ConvertLength(distance, options.Units, constants.UnitMeters)
+	if err != nil {
+		return nil, err
+	}
+	d, _ := vincentyDirect(p1, conversions.DegreesToRadians(bearing), s)
+	return &d, nil
+}
+
+func geodesicDefaults(options *GeodesicOptions) *GeodesicOptions {
+	o := GeodesicOptions{Model: WGS84, Units: constants.UnitDefault}
+	if options != nil {
+		if options.Model != "" {
+			o.Model = options.Model
+		}
+		if options.Units != "" {
+			o.Units = options.Units
+		}
+	}
+	return &o
+}
+
+func normalizeBearing(b float64) float64 {
+	b = math.Mod(b, 360)
+	if b < 0 {
+		b += 360
+	}
+	if b >= 360 {
+		// a tiny negative bearing rounds to 360
+		b = 0
+	}
+	return b
+}
+
+// geodesicInverse returns the distance in meters, the initial and the final azimuth in radians between two points on the WGS84 ellipsoid.
+func geodesicInverse(p1 geometry.Point, p2 geometry.Point) (float64, float64, float64, error) {
+	if p1.Lat == -p2.Lat && math.Abs(math.Remainder(p2.Lng-p1.Lng, 360)) == 180 {
+		// the shortest geodesics between antipodal points follow the meridians, take the one over the north pole
+		return halfMeridian(), 0, math.Pi, nil
+	}
+	s, α1, α2, err := vincentyInverse(p1, p2)
+	if err == nil {
+		return s, α1, α2, nil
+	}
+	return newtonInverse(p1, p2)
+}
+
+// halfMeridian returns the length in meters of the meridian from pole to pole.
+// ref. C. F. F. Karney, "Algorithms for geodesics", J. Geodesy 87, 2013, eq. 17
+func halfMeridian() float64 {
+	a := constants.WGS84SemiMajorAxis
+	f := constants.WGS84Flattening
+	n := f / (2 - f)
+	return math.Pi * a / (1 + n) * (1 + n*n/4 + n*n*n*n/64)
+}
+
+// vincentyInverse solves the inverse geodesic problem.
+// ref. T. Vincenty, "Direct and Inverse Solutions of Geodesics on the Ellipsoid with application of nested equations", Survey Review, 1975
+func vincentyInverse(p1 geometry.Point, p2 geometry.Point) (float64, float64, float64, error) {
+	a := constants.WGS84SemiMajorAxis
+	f := constants.WGS84Flattening
+	b := a * (1 - f)
+
+	L := conversions.DegreesToRadians(p2.Lng - p1.Lng)
+	U1 := math.Atan((1 - f) * math.Tan(conversions.DegreesToRadians(p1.Lat)))
+	U2 := math.Atan((1 - f) * math.Tan(conversions.DegreesToRadians(p2.Lat)))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+	// sin(U2-U1) avoids the cancellation of cosU1·sinU2 - sinU1·cosU2 between close points
+	sinΔU := math.Sin(U2 - U1)
+
+	λ := L
+	var sinλ, cosλ, haversine, sinσ, cosσ, σ, cos2α, cos2σm float64
+	converged := false
+	for i := 0; i < vincentyMaxIterations; i++ {
+		sinλ, cosλ = math.Sincos(λ)
+		// 1 - cosλ = 2·sin²(λ/2) keeps the precision of short lines
+		haversine = 2 * math.Pow(math.Sin(λ/2), 2)
+		sinσ = math.Hypot(cosU2*sinλ, sinΔU+sinU1*cosU2*haversine)
+		if sinσ == 0 {
+			// coincident points
+			return 0, 0, 0, nil
+		}
+		cosσ = sinU1*sinU2 + cosU1*cosU2*cosλ
+		σ = math.Atan2(sinσ, cosσ)
+		sinα := cosU1 * cosU2 * sinλ / sinσ
+		cos2α = 1 - sinα*sinα
+		cos2σm = 0.0
+		if cos2α != 0 {
+			// on the equatorial line cos²α = 0
+			cos2σm = cosσ - 2*sinU1*sinU2/cos2α
+		}
+		C := f / 16 * cos2α * (4 + f*(4-3*cos2α))
+		λp := λ
+		λ = L + (1-C)*f*sinα*(σ+C*sinσ*(cos2σm+C*cosσ*(-1+2*cos2σm*cos2σm)))
+		if math.Abs(λ) > math.Pi {
+			return 0, 0, 0, errVincentyConvergence
+		}
+		if math.Abs(λ-λp) < vincentyTolerance {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return 0, 0, 0, errVincentyConvergence
+	}
+
+	u2 := cos2α * (a*a - b*b) / (b * b)
+	A := 1 + u2/16384*(4096+u2*(-768+u2*(320-175*u2)))
+	B := u2 / 1024 * (256 + u2*(-128+u2*(74-47*u2)))
+	Δσ := B * sinσ * (cos2σm + B/4*(cosσ*(-1+2*cos2σm*cos2σm)-B/6*cos2σm*(-3+4*sinσ*sinσ)*(-3+4*cos2σm*cos2σm)))
+
+	s := b * A * (σ - Δσ)
+	// the azimuths use the converged λ rather than the one of the last iteration
+	sinλ = math.Sin(λ)
+	haversine = 2 * math.Pow(math.Sin(λ/2), 2)
+	α1 := math.Atan2(cosU2*sinλ, sinΔU+sinU1*cosU2*haversine)
+	α2 := math.Atan2(cosU1*sinλ, sinΔU-cosU1*sinU2*haversine)
+	return s, α1, α2, nil
+}
+
+// vincentyDirect solves the direct geodesic problem, it returns the destination point and the final azimuth in radians.
+func vincentyDirect(p geometry.Point, α1 float64, s float64) (geometry.Point, float64) {
+	a := constants.WGS84SemiMajorAxis
+	f := constants.WGS84Flattening
+	b := a * (1 - f)
+
+	sinα1, cosα1 := math.Sincos(α1)
+	tanU1 := (1 - f) * math.Tan(conversions.DegreesToRadians(p.Lat))
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+	σ1 := math.Atan2(tanU1, cosα1)
+	sinα := cosU1 * sinα1
+	cos2α := 1 - sinα*sinα
+	u2 := cos2α * (a*a - b*b) / (b * b)
+	A := 1 + u2/16384*(4096+u2*(-768+u2*(320-175*u2)))
+	B := u2 / 1024 * (256 + u2*(-128+u2*(74-47*u2)))
+
+	σ := s / (b * A)
+	var sinσ, cosσ, cos2σm float64
+	for i := 0; i < vincentyMaxIterations; i++ {
+		cos2σm = math.Cos(2*σ1 + σ)
+		sinσ, cosσ = math.Sincos(σ)
+		Δσ := B * sinσ * (cos2σm + B/4*(cosσ*(-1+2*cos2σm*cos2σm)-B/6*cos2σm*(-3+4*sinσ*sinσ)*(-3+4*cos2σm*cos2σm)))
+		σp := σ
+		σ = s/(b*A) + Δσ
+		if math.Abs(σ-σp) < vincentyTolerance {
+			break
+		}
+	}
+	cos2σm = math.Cos(2*σ1 + σ)
+	sinσ, cosσ = math.Sincos(σ)
+
+	x := sinU1*sinσ - cosU1*cosσ*cosα1
+	φ2 := math.Atan2(sinU1*cosσ+cosU1*sinσ*cosα1, (1-f)*math.Hypot(sinα, x))
+	λ := math.Atan2(sinσ*sinα1, cosU1*cosσ-sinU1*sinσ*cosα1)
+	C := f / 16 * cos2α * (4 + f*(4-3*cos2α))
+	L := λ - (1-C)*f*sinα*(σ+C*sinσ*(cos2σm+C*cosσ*(-1+2*cos2σm*cos2σm)))
+	λ2 := conversions.DegreesToRadians(p.Lng) + L
+	α2 := math.Atan2(sinα, -x)
+
+	// normalise to -180...+180
+	lng := math.Mod(conversions.RadiansToDegrees(λ2)+540, 360) - 180
+	return geometry.Point{Lat: conversions.RadiansToDegrees(φ2), Lng: lng}, α2
+}
+
+// newtonInverse solves the inverse geodesic problem by correcting the initial azimuth and the distance of the direct
+// problem until the destination reaches p2. It is used for the near-antipodal points where vincentyInverse doesn't converge.
+// Besides the spherical azimuth, the iterations also start heading north and south because the equator is a geodesic
+// but not the shortest one between nearly antipodal equatorial points.
+func newtonInverse(p1 geometry.Point, p2 geometry.Point) (float64, float64, float64, error) {
+	δ, _ := PointDistance(p1, p2, constants.UnitRadians)
+	s0 := δ * constants.EarthRadius
+	starts := []float64{conversions.DegreesToRadians(PointBearing(p1, p2)), 0, math.Pi}
+
+	best := math.Inf(1)
+	var bestα1, bestα2 float64
+	for _, start := range starts {
+		s, α1, α2, ok := newtonIterations(p1, p2, start, s0)
+		if ok && s >= 0 && s < best {
+			best, bestα1, bestα2 = s, α1, α2
+		}
+	}
+	if math.IsInf(best, 1) {
+		return 0, 0, 0, errGeodesicConvergence
+	}
+	return best, bestα1, bestα2, nil
+}
+
+func newtonIterations(p1 geometry.Point, p2 geometry.Point, α1 float64, s float64) (float64, float64, float64, bool) {
+	h := 1e-7
+	var α2 float64
+	for i := 0; i < geodesicMaxIterations; i++ {
+		var d geometry.Point
+		d, α2 = vincentyDirect(p1, α1, s)
+		along, cross := trackOffsets(d, α2, p2)
+		if math.Hypot(along, cross) < geodesicTolerance {
+			return s, α1, α2, true
+		}
+		// the sideways displacement of the destination per radian of azimuth (reduced length)
+		dh, _ := vincentyDirect(p1, α1+h, s)
+		_, m := trackOffsets(d, α2, dh)
+		m /= h
+		s += along
+		if math.Abs(m) > geodesicTolerance {
+			Δα := cross / m
+			// damp the azimuth corrections, they are large close to the conjugate point
+			Δα = math.Max(-0.5, math.Min(0.5, Δα))
+			α1 += Δα
+		}
+	}
+	return s, α1, α2, false
+}
+
+// trackOffsets returns the distance in meters of the target from the origin along and across the given azimuth.
+func trackOffsets(origin geometry.Point, azimuth float64, target geometry.Point) (float64, float64) {
+	δ, _ := PointDistance(origin, target, constants.UnitRadians)
+	d := δ * constants.EarthRadius
+	θ := conversions.DegreesToRadians(PointBearing(origin, target)) - azimuth
+	return d * math.Cos(θ), d * math.Sin(θ)
+}
@@ -0,0 +1,148 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// Flinders Peak to Buninyong, the example of Vincenty's paper
+var flindersPeak = geometry.Point{Lng: 144.42486788888888, Lat: -37.95103341666667}
+var buninyong = geometry.Point{Lng: 143.92649552777777, Lat: -37.65282113888889}
+
+func TestGeodesicDistance(t *testing.T) {
+	d, err := GeodesicDistance(flindersPeak, buninyong, &GeodesicOptions{Units: constants.UnitMeters})
+	if err != nil {
+		t.Errorf("GeodesicDistance error %v", err)
+	}
+	assert.True(t, math.Abs(d-54972.271) < 1e-3)
+
+	d, err = GeodesicDistance(flindersPeak, buninyong, nil)
+	if err != nil {
+		t.Errorf("GeodesicDistance error %v", err)
+	}
+	assert.True(t, math.Abs(d-54.972271) < 1e-6)
+
+	d, err = GeodesicDistance(flindersPeak, flindersPeak, nil)
+	if err != nil {
+		t.Errorf("GeodesicDistance error %v", err)
+	}
+	assert.Equal(t, d, 0.0)
+}
+
+func TestGeodesicDistanceSphere(t *testing.T) {
+	p1 := geometry.Point{Lng: -75.343, Lat: 39.984}
+	p2 := geometry.Point{Lng: -75.534, Lat: 39.123}
+	d, err := GeodesicDistance(p1, p2, &GeodesicOptions{Model: Sphere, Units: constants.UnitKilometers})
+	if err != nil {
+		t.Errorf("GeodesicDistance error %v", err)
+	}
+	assert.Equal(t, d, 97.12922118967835)
+
+	_, err = GeodesicDistance(p1, p2, &GeodesicOptions{Model: "Mars"})
+	if err == nil {
+		t.Errorf("expected an invalid earth model error")
+	}
+}
+
+func TestGeodesicDistanceNearAntipodal(t *testing.T) {
+	// reference values from C. F. F. Karney, "Algorithms for geodesics", J. Geodesy 87, 2013
+	p1 := geometry.Point{Lng: 0, Lat: -30}
+	p2 := geometry.Point{Lng: 179.8, Lat: 29.9}
+	d, err := GeodesicDistance(p1, p2, &GeodesicOptions{Units: constants.UnitMeters})
+	if err != nil {
+		t.Errorf("GeodesicDistance error %v", err)
+	}
+	assert.True(t, math.Abs(d-19989832.8276) < 1e-3)
+
+	b, err := GeodesicBearing(p1, p2, nil)
+	if err != nil {
+		t.Errorf("GeodesicBearing error %v", err)
+	}
+	assert.True(t, math.Abs(b-161.890524737) < 1e-6)
+
+	// antipodal points on the equator, the shortest path crosses the pole
+	d, err = GeodesicDistance(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 180, Lat: 0}, &GeodesicOptions{Units: constants.UnitMeters})
+	if err != nil {
+		t.Errorf("GeodesicDistance error %v", err)
+	}
+	assert.True(t, math.Abs(d-20003931.4586) < 1e-3)
+
+	// antipodal points off the equator are joined by the meridians
+	options := &GeodesicOptions{Units: constants.UnitMeters}
+	p1 = geometry.Point{Lng: 20, Lat: 10}
+	d, err = GeodesicDistance(p1, geometry.Point{Lng: -160, Lat: -10}, options)
+	if err != nil {
+		t.Errorf("GeodesicDistance error %v", err)
+	}
+	assert.True(t, math.Abs(d-20003931.4586) < 1e-3)
+	b, err = GeodesicBearing(p1, geometry.Point{Lng: -160, Lat: -10}, nil)
+	if err != nil {
+		t.Errorf("GeodesicBearing error %v", err)
+	}
+	assert.Equal(t, b, 0.0)
+
+	// near-antipodal points, the destination of the solution is the second point
+	for _, p2 := range []geometry.Point{{Lng: -160, Lat: -10.001}, {Lng: -159.999, Lat: -10}, {Lng: -159.9, Lat: -10.1}, {Lng: -160.3, Lat: -9.5}} {
+		d, err = GeodesicDistance(p1, p2, options)
+		if err != nil {
+			t.Errorf("GeodesicDistance error %v", err)
+			return
+		}
+		assert.True(t, d > 0 && d < 20003931.4586)
+		b, err = GeodesicBearing(p1, p2, nil)
+		if err != nil {
+			t.Errorf("GeodesicBearing error %v", err)
+			return
+		}
+		dest, err := GeodesicDestination(p1, d, b, options)
+		if err != nil {
+			t.Errorf("GeodesicDestination error %v", err)
+			return
+		}
+		assert.True(t, math.Abs(dest.Lat-p2.Lat) < 1e-9 && math.Abs(dest.Lng-p2.Lng) < 1e-9)
+	}
+}
+
+func TestNormalizeBearing(t *testing.T) {
+	assert.Equal(t, normalizeBearing(-90), 270.0)
+	assert.Equal(t, normalizeBearing(720), 0.0)
+	// a tiny negative bearing doesn't round to 360
+	assert.Equal(t, normalizeBearing(-1e-15), 0.0)
+}
+
+func TestGeodesicBearing(t *testing.T) {
+	b, err := GeodesicBearing(flindersPeak, buninyong, nil)
+	if err != nil {
+		t.Errorf("GeodesicBearing error %v", err)
+	}
+	// 306°52'05.37"
+	assert.True(t, math.Abs(b-306.86815920) < 1e-6)
+
+	b, err = GeodesicBearing(geometry.Point{Lng: -77.03653, Lat: 38.89768}, geometry.Point{Lng: -77.05173, Lat: 38.8973}, &GeodesicOptions{Model: Sphere})
+	if err != nil {
+		t.Errorf("GeodesicBearing error %v", err)
+	}
+	assert.Equal(t, b, 268.16492117999513)
+}
+
+func TestGeodesicDestination(t *testing.T) {
+	d, err := GeodesicDestination(flindersPeak, 54972.271, 306.86815920, &GeodesicOptions{Units: constants.UnitMeters})
+	if err != nil {
+		t.Errorf("GeodesicDestination error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(d.Lat-buninyong.Lat) < 1e-8)
+	assert.True(t, math.Abs(d.Lng-buninyong.Lng) < 1e-8)
+
+	p := geometry.Point{Lat: 23.34, Lng: 43.25}
+	d, err = GeodesicDestination(p, 10, 230, &GeodesicOptions{Model: Sphere})
+	if err != nil {
+		t.Errorf("GeodesicDestination error %v", err)
+		return
+	}
+	assert.Equal(t, *d, geometry.Point{Lat: 23.282174951509955, Lng: 43.17500084522403})
+}
@@ -0,0 +1,350 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// GeodesicArea takes a Polygon, MultiPolygon, Feature or FeatureCollection and returns its area on the WGS84 ellipsoid
+// in square meters. Use conversions.ConvertArea for other units.
+//
+// The edges are the geodesics of the ellipsoid and the area of every ring is the sum of the areas between its edges
+// and the equator, so the result doesn't suffer from the spherical approximation of Area. The rings around a pole are
+// supported.
+func GeodesicArea(t interface{}) (float64, error) {
+	polys, err := polygonsOf(t)
+	if err != nil {
+		return 0.0, err
+	}
+	total := 0.0
+	for _, p := range polys {
+		a, err := geodesicPolygonArea(p.Coordinates)
+		if err != nil {
+			return 0.0, err
+		}
+		total += a
+	}
+	return total, nil
+}
+
+// GeodesicPerimeter takes a Polygon, MultiPolygon, Feature or FeatureCollection and returns the length of all its rings
+// measured along the geodesics of the WGS84 ellipsoid.
+func GeodesicPerimeter(t interface{}, units string) (float64, error) {
+	polys, err := polygonsOf(t)
+	if err != nil {
+		return 0.0, err
+	}
+	if units == "" {
+		units = constants.UnitDefault
+	}
+	total := 0.0
+	for _, p := range polys {
+		for _, ring := range p.Coordinates {
+			for i := 1; i < len(ring.Coordinates); i++ {
+				s, _, _, err := geodesicInverse(ring.Coordinates[i-1], ring.Coordinates[i])
+				if err != nil {
+					return 0.0, err
+				}
+				total += s
+			}
+		}
+	}
+	return conversions.ConvertLength(total, constants.UnitMeters, units)
+}
+
+// polygonsOf returns the polygons of a Polygon, MultiPolygon, Feature, Geometry or FeatureCollection.
+// Other geometry types have no area and are skipped.
+func polygonsOf(t interface{}) ([]geometry.Polygon, error) {
+	switch gtp := t.(type) {
+	case geometry.Polygon:
+		return []geometry.Polygon{gtp}, nil
+	case *geometry.Polygon:
+		return []geometry.Polygon{*gtp}, nil
+	case geometry.MultiPolygon:
+		return gtp.Coordinates, nil
+	case *geometry.MultiPolygon:
+		return gtp.Coordinates, nil
+	case feature.Feature:
+		return polygonsOfGeometry(gtp.Geometry)
+	case *feature.Feature:
+		return polygonsOfGeometry(gtp.Geometry)
+	case geometry.Geometry:
+		return polygonsOfGeometry(gtp)
+	case *geometry.Geometry:
+		return polygonsOfGeometry(*gtp)
+	case feature.Collection:
+		return polygonsOfFeatures(gtp.Features)
+	case *feature.Collection:
+		return polygonsOfFeatures(gtp.Features)
+	}
+	return nil, errors.New("unsupported geojson type")
+}
+
+func polygonsOfFeatures(features []feature.Feature) ([]geometry.Polygon, error) {
+	polys := []geometry.Polygon{}
+	for _, f := range features {
+		p, err := polygonsOfGeometry(f.Geometry)
+		if err != nil {
+			return nil, err
+		}
+		polys = append(polys, p...)
+	}
+	return polys, nil
+}
+
+func polygonsOfGeometry(g geometry.Geometry) ([]geometry.Polygon, error) {
+	switch g.GeoJSONType {
+	case geojson.Polygon:
+		poly, err := g.ToPolygon()
+		if err != nil {
+			return nil, errors.New("cannot convert geometry to Polygon")
+		}
+		return []geometry.Polygon{*poly}, nil
+	case geojson.MultiPolygon:
+		multiPoly, err := g.ToMultiPolygon()
+		if err != nil {
+			return nil, errors.New("cannot convert geometry to MultiPolygon")
+		}
+		return multiPoly.Coordinates, nil
+	}
+	return []geometry.Polygon{}, nil
+}
+
+func geodesicPolygonArea(rings []geometry.LineString) (float64, error) {
+	total := 0.0
+	for i, ring := range rings {
+		a, err := geodesicRingArea(ring.Coordinates)
+		if err != nil {
+			return 0.0, err
+		}
+		if i == 0 {
+			total += math.Abs(a)
+		} else {
+			total -= math.Abs(a)
+		}
+	}
+	return total, nil
+}
+
+// nC4 is the order of the series of the area between a geodesic and the equator.
+const nC4 = 6
+
+// c4Coefficients are the coefficients of the C4 series as polynomials in the third flattening n, each polynomial is
+// followed by its divisor.
+// ref. C. F. F. Karney, "Algorithms for geodesics", J. Geodesy 87, 2013, eq. 62-64 and GeographicLib
+var c4Coefficients = []float64{
+	// C4[0]
+	97, 15015,
+	1088, 156, 45045,
+	-224, -4784, 1573, 45045,
+	-10656, 14144, -4576, -858, 45045,
+	64, 624, -4576, 6864, -3003, 15015,
+	100, 208, 572, 3432, -12012, 30030, 45045,
+	// C4[1]
+	1, 9009,
+	-2944, 468, 135135,
+	5792, 1040, -1287, 135135,
+	5952, -11648, 9152, -2574, 135135,
+	-64, -624, 4576, -6864, 3003, 135135,
+	// C4[2]
+	8, 10725,
+	1856, -936, 225225,
+	-8448, 4992, -1144, 225225,
+	-1440, 4160, -4576, 1716, 225225,
+	// C4[3]
+	-136, 63063,
+	1024, -208, 105105,
+	3584, -3328, 1144, 315315,
+	// C4[4]
+	-128, 135135,
+	-2560, 832, 405405,
+	// C4[5]
+	128, 99099,
+}
+
+// ellipsoidArea contains the constants of the WGS84 ellipsoid used by the geodesic areas.
+type ellipsoidArea struct {
+	e2  float64
+	ep2 float64
+	// c2 is the authalic radius squared
+	c2 float64
+	// c4x are the coefficients of the C4 series in eps, for the third flattening of the ellipsoid
+	c4x []float64
+}
+
+func newEllipsoidArea() *ellipsoidArea {
+	a := constants.WGS84SemiMajorAxis
+	f := constants.WGS84Flattening
+	b := a * (1 - f)
+	e2 := f * (2 - f)
+	n := f / (2 - f)
+	e := math.Sqrt(e2)
+	el := &ellipsoidArea{
+		e2:  e2,
+		ep2: e2 / (1 - e2),
+		c2:  (a*a + b*b*math.Atanh(e)/e) / 2,
+	}
+	o := 0
+	for l := 0; l < nC4; l++ {
+		for j := nC4 - 1; j >= l; j-- {
+			m := nC4 - j - 1
+			el.c4x = append(el.c4x, polyval(m, c4Coefficients[o:], n)/c4Coefficients[o+m+1])
+			o += m + 2
+		}
+	}
+	return el
+}
+
+// polyval evaluates the polynomial of degree n with the coefficients p, the highest degree first.
+func polyval(n int, p []float64, x float64) float64 {
+	y := p[0]
+	for i := 1; i <= n; i++ {
+		y = y*x + p[i]
+	}
+	return y
+}
+
+// geodesicRingArea returns the signed area of a ring bounded by geodesics of the WGS84 ellipsoid in square meters,
+// positive for counter-clockwise rings. The rings around a pole are handled by counting the crossings of the prime
+// meridian.
+// ref. C. F. F. Karney, "Algorithms for geodesics", J. Geodesy 87, 2013, section 6
+func geodesicRingArea(coords []geometry.Point) (float64, error) {
+	if len(coords) < 3 {
+		return 0.0, nil
+	}
+	el := newEllipsoidArea()
+	area0 := 4 * math.Pi * el.c2
+
+	total := 0.0
+	crossings := 0
+	n := len(coords)
+	for i := 0; i < n; i++ {
+		p1 := coords[i]
+		p2 := coords[(i+1)%n]
+		// take the shorter way across the anti-meridian
+		lon12 := angleDifference(p1.Lng, p2.Lng)
+		s12, err := el.edgeArea(p1, geometry.Point{Lng: p1.Lng + lon12, Lat: p2.Lat})
+		if err != nil {
+			return 0.0, err
+		}
+		total += s12
+		crossings += transit(p1.Lng, p2.Lng)
+	}
+
+	// the edges sum the area clockwise between them and the equator, reduce it to the area of the ring
+	total = math.Remainder(total, area0)
+	if crossings%2 != 0 {
+		if total < 0 {
+			total += area0 / 2
+		} else {
+			total -= area0 / 2
+		}
+	}
+	total = -total
+	if total > area0/2 {
+		total -= area0
+	} else if total <= -area0/2 {
+		total += area0
+	}
+	return total, nil
+}
+
+// edgeArea returns the area between the geodesic from p1 to p2 and the equator, S12 in Karney's paper.
+func (el *ellipsoidArea) edgeArea(p1 geometry.Point, p2 geometry.Point) (float64, error) {
+	_, α1, α2, err := geodesicInverse(p1, p2)
+	if err != nil {
+		return 0.0, err
+	}
+	f := constants.WGS84Flattening
+	β1 := math.Atan((1 - f) * math.Tan(conversions.DegreesToRadians(p1.Lat)))
+	β2 := math.Atan((1 - f) * math.Tan(conversions.DegreesToRadians(p2.Lat)))
+	sinβ1, cosβ1 := math.Sincos(β1)
+	sinβ2, cosβ2 := math.Sincos(β2)
+	sinα1, cosα1 := math.Sincos(α1)
+	_, cosα2 := math.Sincos(α2)
+
+	s12 := 0.0
+	// azimuth of the geodesic at the equator
+	sinα0 := sinα1 * cosβ1
+	cosα0 := math.Hypot(cosα1, sinα1*sinβ1)
+	if sinα0 != 0 && cosα0 != 0 {
+		// arc lengths from the equator on the auxiliary sphere
+		σ1 := math.Atan2(sinβ1, cosα1*cosβ1)
+		σ2 := math.Atan2(sinβ2, cosα2*cosβ2)
+		k2 := cosα0 * cosα0 * el.ep2
+		eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+		a := constants.WGS84SemiMajorAxis
+		A4 := a * a * cosα0 * sinα0 * el.e2
+		c4 := el.c4(eps)
+		s12 = A4 * (cosSeries(σ2, c4) - cosSeries(σ1, c4))
+	}
+	α12 := math.Remainder(α2-α1, 2*math.Pi)
+	return s12 + el.c2*α12, nil
+}
+
+// c4 returns the coefficients of the C4 series for eps.
+func (el *ellipsoidArea) c4(eps float64) []float64 {
+	c := make([]float64, nC4)
+	mult := 1.0
+	o := 0
+	for l := 0; l < nC4; l++ {
+		m := nC4 - l - 1
+		c[l] = mult * polyval(m, el.c4x[o:], eps)
+		o += m + 1
+		mult *= eps
+	}
+	return c
+}
+
+// cosSeries returns the sum of c[l]·cos((2l+1)σ) with Clenshaw summation.
+func cosSeries(σ float64, c []float64) float64 {
+	sinσ, cosσ := math.Sincos(σ)
+	ar := 2 * (cosσ - sinσ) * (cosσ + sinσ)
+	y0, y1 := 0.0, 0.0
+	k := len(c)
+	if k%2 != 0 {
+		k--
+		y0 = c[k]
+	}
+	for k > 0 {
+		k--
+		y1 = ar*y0 - y1 + c[k]
+		k--
+		y0 = ar*y1 - y0 + c[k]
+	}
+	return cosσ * (y0 - y1)
+}
+
+// angleDifference returns lon2 - lon1 in degrees reduced to [-180, 180].
+func angleDifference(lon1 float64, lon2 float64) float64 {
+	return math.Remainder(lon2-lon1, 360)
+}
+
+// transit returns 1 or -1 when the edge from lon1 to lon2 crosses the prime meridian eastwards or westwards, 0 otherwise.
+func transit(lon1 float64, lon2 float64) int {
+	lon12 := angleDifference(lon1, lon2)
+	lon1 = normalizeLongitude(lon1)
+	lon2 = normalizeLongitude(lon2)
+	switch {
+	case lon12 > 0 && ((lon1 < 0 && lon2 >= 0) || (lon1 > 0 && lon2 == 0)):
+		return 1
+	case lon12 < 0 && lon2 < 0 && lon1 >= 0:
+		return -1
+	}
+	return 0
+}
+
+// normalizeLongitude returns the longitude in [-180, 180).
+func normalizeLongitude(lon float64) float64 {
+	l := math.Mod(lon+180, 360)
+	if l < 0 {
+		l += 360
+	}
+	return l - 180
+}
@@ -0,0 +1,155 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+	"github.com/et-soft/turf-go/utils"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestGeodesicAreaParallelCell(t *testing.T) {
+	// a 1°x1° cell whose northern edge follows the parallel, its exact area is a²·Δλ·(q(φ2)-q(φ1))/2
+	pts := []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}}
+	for i := 0; i <= 1000; i++ {
+		pts = append(pts, geometry.Point{Lng: 1 - float64(i)/1000, Lat: 1})
+	}
+	pts = append(pts, geometry.Point{Lng: 0, Lat: 0})
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: pts}}}
+
+	area, err := GeodesicArea(&poly)
+	if err != nil {
+		t.Errorf("GeodesicArea error %v", err)
+	}
+	// the geodesic chords of the parallel add less than a square meter
+	assert.True(t, math.Abs(area-12308463893.975) < 5)
+}
+
+func TestGeodesicAreaPole(t *testing.T) {
+	// the reference areas are from GeographicLib
+	cap := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 89}, {Lng: 90, Lat: 89}, {Lng: 180, Lat: 89}, {Lng: 270, Lat: 89}, {Lng: 0, Lat: 89},
+	}}}}
+	area, err := GeodesicArea(&cap)
+	if err != nil {
+		t.Errorf("GeodesicArea error %v", err)
+	}
+	assert.True(t, math.Abs(area-24952305678) < 1)
+
+	// the same ring clockwise encloses the same cap
+	reversed := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 89}, {Lng: -90, Lat: 89}, {Lng: 180, Lat: 89}, {Lng: 90, Lat: 89}, {Lng: 0, Lat: 89},
+	}}}}
+	area, err = GeodesicArea(&reversed)
+	if err != nil {
+		t.Errorf("GeodesicArea error %v", err)
+	}
+	assert.True(t, math.Abs(area-24952305678) < 1)
+
+	octant := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 90}, {Lng: 0, Lat: 0}, {Lng: 90, Lat: 0}, {Lng: 0, Lat: 90},
+	}}}}
+	area, err = GeodesicArea(&octant)
+	if err != nil {
+		t.Errorf("GeodesicArea error %v", err)
+	}
+	assert.True(t, math.Abs(area-63758202715511) < 1)
+
+	diamond := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: -1, Lat: 0}, {Lng: 0, Lat: -1}, {Lng: 1, Lat: 0}, {Lng: 0, Lat: 1}, {Lng: -1, Lat: 0},
+	}}}}
+	area, err = GeodesicArea(&diamond)
+	if err != nil {
+		t.Errorf("GeodesicArea error %v", err)
+	}
+	assert.True(t, math.Abs(area-24619419146) < 1)
+}
+
+func TestGeodesicAreaWithHole(t *testing.T) {
+	outer := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0.01, Lat: 0}, {Lng: 0.01, Lat: 0.01}, {Lng: 0, Lat: 0.01}, {Lng: 0, Lat: 0}}}
+	hole := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0.002, Lat: 0.002}, {Lng: 0.002, Lat: 0.004}, {Lng: 0.004, Lat: 0.004}, {Lng: 0.004, Lat: 0.002}, {Lng: 0.002, Lat: 0.002}}}
+
+	full, err := GeodesicArea(&geometry.Polygon{Coordinates: []geometry.LineString{outer}})
+	if err != nil {
+		t.Errorf("GeodesicArea error %v", err)
+	}
+	holed, err := GeodesicArea(&geometry.Polygon{Coordinates: []geometry.LineString{outer, hole}})
+	if err != nil {
+		t.Errorf("GeodesicArea error %v", err)
+	}
+	assert.True(t, math.Abs(full/holed-25.0/24.0) < 1e-6)
+
+	mp := geometry.MultiPolygon{Coordinates: []geometry.Polygon{{Coordinates: []geometry.LineString{outer}}, {Coordinates: []geometry.LineString{outer, hole}}}}
+	total, err := GeodesicArea(&mp)
+	if err != nil {
+		t.Errorf("GeodesicArea error %v", err)
+	}
+	assert.True(t, math.Abs(total-(full+holed)) < 1e-6)
+
+	ha, err := conversions.ConvertArea(full, constants.UnitMeters, constants.UnitHectares)
+	if err != nil {
+		t.Errorf("ConvertArea error %v", err)
+	}
+	assert.True(t, math.Abs(ha-123.0) < 0.5)
+}
+
+func TestGeodesicAreaFeatureCollection(t *testing.T) {
+	gjson, err := utils.LoadJSONFixture(AreaFeatureCollection)
+	if err != nil {
+		t.Errorf("LoadJSONFixture error: %v", err)
+	}
+	collection, err := feature.CollectionFromJSON(gjson)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error: %v", err)
+	}
+	area, err := GeodesicArea(collection)
+	if err != nil {
+		t.Errorf("GeodesicArea error: %v", err)
+	}
+	spherical, err := Area(collection)
+	if err != nil {
+		t.Errorf("Area error: %v", err)
+	}
+	// the spherical approximation is within 1% of the ellipsoidal area
+	assert.True(t, math.Abs(area-spherical)/area < 0.01)
+
+	_, err = GeodesicArea(geometry.Point{})
+	if err == nil {
+		t.Errorf("expected an unsupported type error")
+	}
+}
+
+func TestGeodesicPerimeter(t *testing.T) {
+	ring := geometry.LineString{Coordinates: []geometry.Point{flindersPeak, buninyong, flindersPeak}}
+	p, err := GeodesicPerimeter(&geometry.Polygon{Coordinates: []geometry.LineString{ring}}, constants.UnitMeters)
+	if err != nil {
+		t.Errorf("GeodesicPerimeter error %v", err)
+	}
+	assert.True(t, math.Abs(p-2*54972.271) < 2e-3)
+
+	gjson, err := utils.LoadJSONFixture(AreaPolygon)
+	if err != nil {
+		t.Errorf("LoadJSONFixture error: %v", err)
+	}
+	f, err := feature.FromJSON(gjson)
+	if err != nil {
+		t.Errorf("FromJSON error: %v", err)
+	}
+	p, err = GeodesicPerimeter(f, "")
+	if err != nil {
+		t.Errorf("GeodesicPerimeter error %v", err)
+	}
+	poly, err := f.ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error %v", err)
+	}
+	l, err := Length(*poly, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Length error %v", err)
+	}
+	assert.True(t, math.Abs(p-l)/l < 0.005)
+}
@@ -0,0 +1,128 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// IntermediatePoint returns the point at the given fraction between start and end along the great circle path.
+// fraction 0 returns start and fraction 1 returns end.
+// ref. http://www.movable-type.co.uk/scripts/latlong.html#intermediate-point
+func IntermediatePoint(start geometry.Point, end geometry.Point, fraction float64) (*geometry.Point, error) {
+	φ1 := conversions.DegreesToRadians(start.Lat)
+	λ1 := conversions.DegreesToRadians(start.Lng)
+	φ2 := conversions.DegreesToRadians(end.Lat)
+	λ2 := conversions.DegreesToRadians(end.Lng)
+
+	// angular distance between the two points
+	δ, err := Distance(start.Lng, start.Lat, end.Lng, end.Lat, constants.UnitRadians)
+	if err != nil {
+		return nil, err
+	}
+	if δ == 0 {
+		return &geometry.Point{Lat: start.Lat, Lng: start.Lng}, nil
+	}
+	if math.Abs(math.Sin(δ)) < 1e-12 {
+		return nil, errors.New("start and end points are antipodal, the great circle path is undefined")
+	}
+
+	a := math.Sin((1-fraction)*δ) / math.Sin(δ)
+	b := math.Sin(fraction*δ) / math.Sin(δ)
+
+	x := a*math.Cos(φ1)*math.Cos(λ1) + b*math.Cos(φ2)*math.Cos(λ2)
+	y := a*math.Cos(φ1)*math.Sin(λ1) + b*math.Cos(φ2)*math.Sin(λ2)
+	z := a*math.Sin(φ1) + b*math.Sin(φ2)
+
+	φ3 := math.Atan2(z, math.Sqrt(x*x+y*y))
+	λ3 := math.Atan2(y, x)
+
+	return &geometry.Point{Lat: conversions.RadiansToDegrees(φ3), Lng: conversions.RadiansToDegrees(λ3)}, nil
+}
+
+// GreatCircle calculates the great circle route between two points and returns it as a LineString Feature.
+// npoints is the number of points of the route, 100 is the default value.
+// When the route crosses the 180th meridian it is split and a MultiLineString Feature is returned instead.
+func GreatCircle(start geometry.Point, end geometry.Point, npoints int, properties map[string]interface{}) (*feature.Feature, error) {
+	if npoints < 2 {
+		npoints = 100
+	}
+
+	points := []geometry.Point{}
+	for i := 0; i < npoints; i++ {
+		p, err := IntermediatePoint(start, end, float64(i)/float64(npoints-1))
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, *p)
+	}
+
+	return lineFeature(points, properties)
+}
+
+// lineFeature returns the points as a LineString Feature, or as a MultiLineString Feature when they cross the 180th meridian.
+func lineFeature(points []geometry.Point, properties map[string]interface{}) (*feature.Feature, error) {
+	lines := splitAtAntimeridian(points)
+	var g geometry.Geometry
+	if len(lines) == 1 {
+		g = geometry.Geometry{
+			GeoJSONType: geojson.LineString,
+			Coordinates: pointsToCoords(lines[0]),
+		}
+	} else {
+		coords := [][][]float64{}
+		for _, l := range lines {
+			coords = append(coords, pointsToCoords(l))
+		}
+		g = geometry.Geometry{
+			GeoJSONType: geojson.MultiLineString,
+			Coordinates: coords,
+		}
+	}
+
+	return feature.New(g, bboxCalculator(points), properties, "")
+}
+
+// splitAtAntimeridian splits a sequence of points into parts whenever two consecutive points are more than 180 degrees apart.
+// The crossing latitude is interpolated and added at the end of one part and at the start of the next one.
+func splitAtAntimeridian(points []geometry.Point) [][]geometry.Point {
+	lines := [][]geometry.Point{}
+	if len(points) == 0 {
+		return lines
+	}
+	current := []geometry.Point{points[0]}
+	for i := 1; i < len(points); i++ {
+		prev := points[i-1]
+		cur := points[i]
+		dLng := cur.Lng - prev.Lng
+		if math.Abs(dLng) > 180 {
+			// unwrap the longitude of the current point next to the previous one
+			edge := 180.0
+			unwrapped := cur.Lng + 360
+			if dLng > 0 {
+				edge = -180.0
+				unwrapped = cur.Lng - 360
+			}
+			lat := prev.Lat + (cur.Lat-prev.Lat)*(edge-prev.Lng)/(unwrapped-prev.Lng)
+			current = append(current, geometry.Point{Lat: lat, Lng: edge})
+			lines = append(lines, current)
+			current = []geometry.Point{{Lat: lat, Lng: -edge}}
+		}
+		current = append(current, cur)
+	}
+	lines = append(lines, current)
+	return lines
+}
+
+func pointsToCoords(points []geometry.Point) [][]float64 {
+	coords := [][]float64{}
+	for _, p := range points {
+		coords = append(coords, []float64{p.Lng, p.Lat})
+	}
+	return coords
+}
@@ -0,0 +1,101 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestIntermediatePoint(t *testing.T) {
+	start := geometry.Point{Lng: -122, Lat: 48}
+	end := geometry.Point{Lng: -77, Lat: 39}
+
+	p, err := IntermediatePoint(start, end, 0)
+	if err != nil {
+		t.Errorf("IntermediatePoint error %v", err)
+	}
+	assert.True(t, math.Abs(p.Lng-start.Lng) < 1e-9 && math.Abs(p.Lat-start.Lat) < 1e-9)
+
+	p, err = IntermediatePoint(start, end, 1)
+	if err != nil {
+		t.Errorf("IntermediatePoint error %v", err)
+	}
+	assert.True(t, math.Abs(p.Lng-end.Lng) < 1e-9 && math.Abs(p.Lat-end.Lat) < 1e-9)
+
+	p, err = IntermediatePoint(start, end, 0.5)
+	if err != nil {
+		t.Errorf("IntermediatePoint error %v", err)
+	}
+	m := MidPoint(start, end)
+	assert.True(t, math.Abs(p.Lng-m.Lng) < 1e-9 && math.Abs(p.Lat-m.Lat) < 1e-9)
+}
+
+func TestIntermediatePointAntipodal(t *testing.T) {
+	_, err := IntermediatePoint(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 180, Lat: 0}, 0.5)
+	if err == nil {
+		t.Errorf("expected an error for antipodal points")
+	}
+}
+
+func TestGreatCircle(t *testing.T) {
+	start := geometry.Point{Lng: -122, Lat: 48}
+	end := geometry.Point{Lng: -77, Lat: 39}
+	props := map[string]interface{}{"name": "Seattle to DC"}
+
+	gc, err := GreatCircle(start, end, 0, props)
+	if err != nil {
+		t.Errorf("GreatCircle error %v", err)
+		return
+	}
+	assert.Equal(t, gc.Geometry.GeoJSONType, geojson.LineString)
+	assert.Equal(t, gc.Properties["name"], "Seattle to DC")
+
+	ln, err := gc.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+		return
+	}
+	assert.Equal(t, len(ln.Coordinates), 100)
+	assert.True(t, math.Abs(ln.Coordinates[99].Lng-end.Lng) < 1e-9)
+
+	gc, err = GreatCircle(start, end, 5, nil)
+	if err != nil {
+		t.Errorf("GreatCircle error %v", err)
+		return
+	}
+	ln, err = gc.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+		return
+	}
+	assert.Equal(t, len(ln.Coordinates), 5)
+}
+
+func TestGreatCircleAntimeridian(t *testing.T) {
+	start := geometry.Point{Lng: 170, Lat: 10}
+	end := geometry.Point{Lng: -170, Lat: 20}
+
+	gc, err := GreatCircle(start, end, 10, nil)
+	if err != nil {
+		t.Errorf("GreatCircle error %v", err)
+		return
+	}
+	assert.Equal(t, gc.Geometry.GeoJSONType, geojson.MultiLineString)
+
+	ml, err := gc.ToMultiLineString()
+	if err != nil {
+		t.Errorf("ToMultiLineString error %v", err)
+		return
+	}
+	assert.Equal(t, len(ml.Coordinates), 2)
+
+	first := ml.Coordinates[0].Coordinates
+	second := ml.Coordinates[1].Coordinates
+	assert.Equal(t, first[len(first)-1].Lng, 180.0)
+	assert.Equal(t, second[0].Lng, -180.0)
+	assert.Equal(t, first[len(first)-1].Lat, second[0].Lat)
+	assert.Equal(t, len(first)+len(second), 12)
+}
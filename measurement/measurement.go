@@ -4,14 +4,14 @@ import (
 	"errors"
 	"math"
 
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+	"github.com/et-soft/turf-go/internal/common"
+	"github.com/et-soft/turf-go/invariant"
+	meta "github.com/et-soft/turf-go/meta/coordAll"
 	"github.com/tomchavakis/geojson"
 	"github.com/tomchavakis/geojson/feature"
 	"github.com/tomchavakis/geojson/geometry"
-	"github.com/tomchavakis/turf-go/constants"
-	"github.com/tomchavakis/turf-go/conversions"
-	"github.com/tomchavakis/turf-go/internal/common"
-	"github.com/tomchavakis/turf-go/invariant"
-	meta "github.com/tomchavakis/turf-go/meta/coordAll"
 )
 
 // Distance calculates the distance between two points in kilometers. This uses the Haversine formula
@@ -93,21 +93,26 @@ func Destination(p1 geometry.Point, distance float64, bearing float64, units str
 
 // Length measures the length of a geometry.
 func Length(t interface{}, units string) (float64, error) {
+	return LengthWithOptions(t, &LineOptions{Units: units})
+}
 
+// LengthWithOptions measures the length of a geometry along great circle or rhumb line segments.
+func LengthWithOptions(t interface{}, options *LineOptions) (float64, error) {
+	options = lineDefaults(options)
 	result := 0.0
 	var err error
 	var l float64
 	switch gtp := t.(type) {
 	case []geometry.Point:
-		l, err = length(gtp, units)
+		l, err = length(gtp, options)
 		result = l
 	case geometry.LineString:
-		l, err = length(gtp.Coordinates, units)
+		l, err = length(gtp.Coordinates, options)
 		result = l
 	case geometry.MultiLineString:
 		coords := gtp.Coordinates // []LineString
 		for _, c := range coords {
-			l, err = length(c.Coordinates, units)
+			l, err = length(c.Coordinates, options)
 			if err != nil {
 				break
 			}
@@ -115,7 +120,7 @@ func Length(t interface{}, units string) (float64, error) {
 		}
 	case geometry.Polygon:
 		for _, c := range gtp.Coordinates {
-			l, err = length(c.Coordinates, units)
+			l, err = length(c.Coordinates, options)
 			if err != nil {
 				break
 			}
@@ -125,7 +130,7 @@ func Length(t interface{}, units string) (float64, error) {
 		coords := gtp.Coordinates
 		for _, coord := range coords {
 			for _, pl := range coord.Coordinates {
-				l, err = length(pl.Coordinates, units)
+				l, err = length(pl.Coordinates, options)
 				if err != nil {
 					break
 				}
@@ -137,13 +142,13 @@ func Length(t interface{}, units string) (float64, error) {
 }
 
 // http://turfjs.org/docs/#linedistance
-func length(coords []geometry.Point, units string) (float64, error) {
+func length(coords []geometry.Point, options *LineOptions) (float64, error) {
 	travelled := 0.0
 	prevCoords := coords[0]
 	var currentCoords geometry.Point
 	for i := 1; i < len(coords); i++ {
 		currentCoords = coords[i]
-		pd, err := PointDistance(prevCoords, currentCoords, units)
+		pd, err := segmentDistance(prevCoords, currentCoords, options)
 		if err != nil {
 			return 0.0, err
 		}
@@ -279,33 +284,10 @@ func bboxGeom(t interface{}, excludeWrapCoord bool) ([]float64, error) {
 }
 
 // Along Takes a line and returns a point at a specified distance along the line.
-func Along(ln geometry.LineString, distance float64, units string) (*geometry.Point, error) {
-	travelled := 0.0
-	for i := 0; i < len(ln.Coordinates); i++ {
-		if distance >= travelled && i == len(ln.Coordinates)-1 {
-			break
-		} else if travelled >= distance {
-			overshot := distance - travelled
-			if overshot == 0 {
-				return &ln.Coordinates[i], nil
-			}
-			direction := PointBearing(ln.Coordinates[i], ln.Coordinates[i-1]) - 180
-
-			d, err := Destination(ln.Coordinates[i], overshot, direction, units)
-			if err != nil {
-				return nil, err
-			}
-			return d, nil
-		} else {
-			pd, err := PointDistance(ln.Coordinates[i], ln.Coordinates[i+1], units)
-			if err != nil {
-				return nil, err
-			}
-			travelled += pd
-		}
-	}
-
-	return &ln.Coordinates[len(ln.Coordinates)-1], nil
+// The line can be a LineString, a MultiLineString or a Feature of one of them, the parts of a MultiLineString are
+// walked one after the other.
+func Along(t interface{}, distance float64, units string) (*geometry.Point, error) {
+	return AlongWithOptions(t, distance, &LineOptions{Units: units})
 }
 
 // BBoxPolygon takes a BoundingBox and returns an equivalent polygon.
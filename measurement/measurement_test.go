@@ -5,13 +5,13 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/internal/common"
+	"github.com/et-soft/turf-go/utils"
 	"github.com/tomchavakis/geojson"
 	"github.com/tomchavakis/geojson/feature"
 	"github.com/tomchavakis/geojson/geometry"
-	"github.com/tomchavakis/turf-go/assert"
-	"github.com/tomchavakis/turf-go/constants"
-	"github.com/tomchavakis/turf-go/internal/common"
-	"github.com/tomchavakis/turf-go/utils"
 )
 
 const LineDistanceRouteOne = "../test-data/route1.json"
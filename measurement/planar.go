@@ -0,0 +1,261 @@
+package measurement
+
+import (
+	"math"
+	"sort"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// xy is a point of the local plane in meters.
+type xy struct {
+	x float64
+	y float64
+}
+
+func (a xy) sub(b xy) xy {
+	return xy{a.x - b.x, a.y - b.y}
+}
+
+func (a xy) dot(b xy) float64 {
+	return a.x*b.x + a.y*b.y
+}
+
+func (a xy) cross(b xy) float64 {
+	return a.x*b.y - a.y*b.x
+}
+
+func (a xy) norm() float64 {
+	return math.Hypot(a.x, a.y)
+}
+
+// localPlane is an equirectangular projection in meters centered on the origin.
+// It is accurate enough for the extent of a feature, the planar algorithms of the package work on it.
+type localPlane struct {
+	origin geometry.Point
+	cosLat float64
+}
+
+func newLocalPlane(points []geometry.Point) localPlane {
+	bbox := bboxCalculator(points)
+	return localPlaneAt(geometry.Point{Lng: (bbox[0] + bbox[2]) / 2, Lat: (bbox[1] + bbox[3]) / 2})
+}
+
+func localPlaneAt(origin geometry.Point) localPlane {
+	return localPlane{origin: origin, cosLat: math.Cos(conversions.DegreesToRadians(origin.Lat))}
+}
+
+func (lp localPlane) forward(p geometry.Point) xy {
+	return xy{
+		x: conversions.DegreesToRadians(p.Lng-lp.origin.Lng) * lp.cosLat * constants.EarthRadius,
+		y: conversions.DegreesToRadians(p.Lat-lp.origin.Lat) * constants.EarthRadius,
+	}
+}
+
+func (lp localPlane) inverse(p xy) geometry.Point {
+	return geometry.Point{
+		Lng: lp.origin.Lng + conversions.RadiansToDegrees(p.x/(lp.cosLat*constants.EarthRadius)),
+		Lat: lp.origin.Lat + conversions.RadiansToDegrees(p.y/constants.EarthRadius),
+	}
+}
+
+// convexHull returns the counter-clockwise convex hull of the points without repeating the first point.
+// ref. A. M. Andrew, "Another efficient algorithm for convex hulls in two dimensions", 1979
+func convexHull(points []xy) []xy {
+	pts := make([]xy, len(points))
+	copy(pts, points)
+	sort.Slice(pts, func(i, j int) bool {
+		if pts[i].x == pts[j].x {
+			return pts[i].y < pts[j].y
+		}
+		return pts[i].x < pts[j].x
+	})
+	// remove the duplicates
+	unique := []xy{}
+	for i, p := range pts {
+		if i == 0 || p != pts[i-1] {
+			unique = append(unique, p)
+		}
+	}
+	if len(unique) < 3 {
+		return unique
+	}
+
+	hull := []xy{}
+	// lower hull
+	for _, p := range unique {
+		for len(hull) >= 2 && hull[len(hull)-1].sub(hull[len(hull)-2]).cross(p.sub(hull[len(hull)-2])) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	// upper hull
+	lower := len(hull) + 1
+	for i := len(unique) - 2; i >= 0; i-- {
+		p := unique[i]
+		for len(hull) >= lower && hull[len(hull)-1].sub(hull[len(hull)-2]).cross(p.sub(hull[len(hull)-2])) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	return hull[:len(hull)-1]
+}
+
+// rectangle is an oriented rectangle of the local plane.
+type rectangle struct {
+	// corners in counter-clockwise order
+	corners [4]xy
+	// direction of the width side
+	direction xy
+	width     float64
+	height    float64
+}
+
+// minAreaRectangle returns the minimum-area rectangle that encloses a counter-clockwise convex hull.
+// One side of the rectangle is collinear with an edge of the hull, the rotating calipers visit every edge keeping
+// the extreme vertices along the edge and its normal.
+// ref. G. T. Toussaint, "Solving geometric problems with the rotating calipers", 1983
+func minAreaRectangle(hull []xy) rectangle {
+	n := len(hull)
+	if n == 0 {
+		return rectangle{direction: xy{1, 0}}
+	}
+	if n < 3 {
+		d := hull[n-1].sub(hull[0])
+		l := d.norm()
+		dir := xy{1, 0}
+		if l > 0 {
+			dir = xy{d.x / l, d.y / l}
+		}
+		return rectangle{corners: [4]xy{hull[0], hull[n-1], hull[n-1], hull[0]}, direction: dir, width: l}
+	}
+
+	at := func(i int) xy { return hull[i%n] }
+	best := rectangle{width: math.Inf(1), height: math.Inf(1)}
+	// the calipers: farthest vertex along the edge, along its normal and against the edge
+	a, b, c := 1, 1, 1
+	for i := 0; i < n; i++ {
+		d := at(i + 1).sub(at(i))
+		l := d.norm()
+		if l == 0 {
+			continue
+		}
+		e := xy{d.x / l, d.y / l}
+		nrm := xy{-e.y, e.x}
+
+		if a < i+1 {
+			a = i + 1
+		}
+		for k := 0; k < n && at(a+1).dot(e) > at(a).dot(e); k++ {
+			a++
+		}
+		if b < a {
+			b = a
+		}
+		for k := 0; k < n && at(b+1).dot(nrm) > at(b).dot(nrm); k++ {
+			b++
+		}
+		if c < b {
+			c = b
+		}
+		for k := 0; k < n && at(c+1).dot(e) < at(c).dot(e); k++ {
+			c++
+		}
+
+		umin := at(c).dot(e)
+		umax := at(a).dot(e)
+		vmin := at(i).dot(nrm)
+		vmax := at(b).dot(nrm)
+		w := umax - umin
+		h := vmax - vmin
+		if w*h < best.width*best.height {
+			corner := func(u, v float64) xy { return xy{u*e.x + v*nrm.x, u*e.y + v*nrm.y} }
+			best = rectangle{
+				corners:   [4]xy{corner(umin, vmin), corner(umax, vmin), corner(umax, vmax), corner(umin, vmax)},
+				direction: e,
+				width:     w,
+				height:    h,
+			}
+		}
+	}
+	return best
+}
+
+// circle is a circle of the local plane.
+type circle struct {
+	center xy
+	radius float64
+}
+
+func (c circle) contains(p xy) bool {
+	return p.sub(c.center).norm() <= c.radius*(1+1e-12)+1e-9
+}
+
+// minEnclosingCircle returns the smallest circle that contains all the points.
+// ref. E. Welzl, "Smallest enclosing disks (balls and ellipsoids)", 1991
+func minEnclosingCircle(points []xy) circle {
+	if len(points) == 0 {
+		return circle{}
+	}
+	c := circle{center: points[0]}
+	for i := 1; i < len(points); i++ {
+		if c.contains(points[i]) {
+			continue
+		}
+		c = circle{center: points[i]}
+		for j := 0; j < i; j++ {
+			if c.contains(points[j]) {
+				continue
+			}
+			c = circleFrom2(points[i], points[j])
+			for k := 0; k < j; k++ {
+				if !c.contains(points[k]) {
+					c = circleFrom3(points[i], points[j], points[k])
+				}
+			}
+		}
+	}
+	return c
+}
+
+func circleFrom2(a xy, b xy) circle {
+	center := xy{(a.x + b.x) / 2, (a.y + b.y) / 2}
+	return circle{center: center, radius: a.sub(center).norm()}
+}
+
+// circleFrom3 returns the circumcircle of a triangle, or the circle of its longest side when the points are collinear.
+func circleFrom3(a xy, b xy, c xy) circle {
+	ab := b.sub(a)
+	ac := c.sub(a)
+	d := 2 * ab.cross(ac)
+	if d == 0 {
+		candidates := []circle{circleFrom2(a, b), circleFrom2(a, c), circleFrom2(b, c)}
+		best := candidates[0]
+		for _, cc := range candidates[1:] {
+			if cc.radius > best.radius {
+				best = cc
+			}
+		}
+		return best
+	}
+	ux := (ac.y*ab.dot(ab) - ab.y*ac.dot(ac)) / d
+	uy := (ab.x*ac.dot(ac) - ac.x*ab.dot(ab)) / d
+	center := xy{a.x + ux, a.y + uy}
+	return circle{center: center, radius: math.Hypot(ux, uy)}
+}
+
+// ellipseRing returns the closed ring of an ellipse of the local plane with the semi-axis a along the major direction
+// and the semi-axis b across it.
+func ellipseRing(lp localPlane, center xy, a float64, b float64, major xy, steps int) []geometry.Point {
+	minor := xy{-major.y, major.x}
+	ring := []geometry.Point{}
+	for i := 0; i < steps; i++ {
+		θ := 2 * math.Pi * float64(i) / float64(steps)
+		u := a * math.Cos(θ)
+		v := b * math.Sin(θ)
+		ring = append(ring, lp.inverse(xy{center.x + u*major.x + v*minor.x, center.y + u*major.y + v*minor.y}))
+	}
+	return append(ring, ring[0])
+}
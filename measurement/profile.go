@@ -0,0 +1,133 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+)
+
+// Profile is the slope profile of a line with elevation.
+type Profile struct {
+	// Segments of the line in the order of its vertices
+	Segments []ProfileSegment
+	// Distance is the horizontal length of the line
+	Distance float64
+	// Length3D is the length of the line including the altitude differences
+	Length3D float64
+	// Ascent is the total altitude gained in meters
+	Ascent float64
+	// Descent is the total altitude lost in meters, it is positive
+	Descent float64
+}
+
+// ProfileSegment is a segment of a slope profile.
+type ProfileSegment struct {
+	// Start and End are the positions of the segment vertices with their altitude
+	Start []float64
+	End   []float64
+	// Distance is the horizontal length of the segment
+	Distance float64
+	// Length3D is the length of the segment including the altitude difference
+	Length3D float64
+	// Grade is the altitude difference over the horizontal length, 0.1 is a 10% climb and -0.1 a 10% descent.
+	// A vertical segment has an infinite grade
+	Grade float64
+	// Ascent and Descent are cumulative from the start of the line to the end of the segment, in meters
+	Ascent  float64
+	Descent float64
+}
+
+// Length3D measures the length of a LineString or MultiLineString, Geometry or Feature, combining the horizontal
+// geodesic distance of each segment with the altitude difference of its vertices.
+// The altitude is the third coordinate of the positions in meters, a segment with a position without it is measured
+// horizontally.
+func Length3D(t interface{}, options *GeodesicOptions) (float64, error) {
+	options = geodesicDefaults(options)
+	parts, err := linePositions(t)
+	if err != nil {
+		return 0.0, err
+	}
+	total := 0.0
+	for _, part := range parts {
+		for i := 1; i < len(part); i++ {
+			h, err := GeodesicDistance(positionToPoint(part[i-1]), positionToPoint(part[i]), &GeodesicOptions{Model: options.Model, Units: constants.UnitMeters})
+			if err != nil {
+				return 0.0, err
+			}
+			total += math.Hypot(h, altitudeDifference(part[i-1], part[i]))
+		}
+	}
+	return conversions.ConvertLength(total, constants.UnitMeters, options.Units)
+}
+
+// SlopeProfile calculates the grade of every segment of a LineString Geometry or Feature with the cumulative ascent
+// and descent. Every position of the line must have an altitude in meters.
+// The horizontal distances are geodesic, Distance and Length3D are in the units of the options.
+func SlopeProfile(t interface{}, options *GeodesicOptions) (*Profile, error) {
+	options = geodesicDefaults(options)
+	parts, err := linePositions(t)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 1 || len(parts[0]) < 2 {
+		return nil, errors.New("a LineString with at least two positions is required")
+	}
+	line := parts[0]
+	for _, p := range line {
+		if len(p) < 3 {
+			return nil, errors.New("every position must have an altitude")
+		}
+	}
+
+	profile := Profile{}
+	for i := 1; i < len(line); i++ {
+		h, err := GeodesicDistance(positionToPoint(line[i-1]), positionToPoint(line[i]), &GeodesicOptions{Model: options.Model, Units: constants.UnitMeters})
+		if err != nil {
+			return nil, err
+		}
+		dz := line[i][2] - line[i-1][2]
+		if dz > 0 {
+			profile.Ascent += dz
+		} else {
+			profile.Descent -= dz
+		}
+
+		grade := 0.0
+		if h > 0 {
+			grade = dz / h
+		} else if dz != 0 {
+			grade = math.Inf(int(math.Copysign(1, dz)))
+		}
+		distance, err := conversions.ConvertLength(h, constants.UnitMeters, options.Units)
+		if err != nil {
+			return nil, err
+		}
+		length3D, err := conversions.ConvertLength(math.Hypot(h, dz), constants.UnitMeters, options.Units)
+		if err != nil {
+			return nil, err
+		}
+
+		profile.Distance += distance
+		profile.Length3D += length3D
+		profile.Segments = append(profile.Segments, ProfileSegment{
+			Start:    copyPosition(line[i-1]),
+			End:      copyPosition(line[i]),
+			Distance: distance,
+			Length3D: length3D,
+			Grade:    grade,
+			Ascent:   profile.Ascent,
+			Descent:  profile.Descent,
+		})
+	}
+	return &profile, nil
+}
+
+// altitudeDifference returns the altitude difference from p1 to p2, 0 unless both positions have an altitude.
+func altitudeDifference(p1 []float64, p2 []float64) float64 {
+	if len(p1) > 2 && len(p2) > 2 {
+		return p2[2] - p1[2]
+	}
+	return 0.0
+}
@@ -0,0 +1,95 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestLength3D(t *testing.T) {
+	f, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: [][]float64{{0, 0, 0}, {0.01, 0, 500}, {0.02, 0, 0}},
+	}, nil, nil, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+	options := &GeodesicOptions{Units: constants.UnitMeters}
+	h, err := GeodesicDistance(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 0.01, Lat: 0}, options)
+	if err != nil {
+		t.Errorf("GeodesicDistance error %v", err)
+		return
+	}
+
+	l, err := Length3D(f, options)
+	if err != nil {
+		t.Errorf("Length3D error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(l-2*math.Hypot(h, 500)) < 1e-6)
+
+	// without altitude it is the horizontal length
+	flat := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0.01, Lat: 0}}}
+	l, err = Length3D(flat, options)
+	if err != nil {
+		t.Errorf("Length3D error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(l-h) < 1e-6)
+
+	// a position without altitude has no vertical jump from or to its neighbours
+	mixed := geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: [][]float64{{0, 0, 1000}, {0.01, 0}, {0.02, 0, 1000}, {0.03, 0, 1500}},
+	}
+	l, err = Length3D(mixed, options)
+	if err != nil {
+		t.Errorf("Length3D error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(l-(2*h+math.Hypot(h, 500))) < 1e-6)
+}
+
+func TestSlopeProfile(t *testing.T) {
+	f, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: [][]float64{{0, 0, 100}, {0.01, 0, 200}, {0.02, 0, 150}, {0.02, 0, 180}},
+	}, nil, nil, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+	options := &GeodesicOptions{Units: constants.UnitMeters}
+	h, err := GeodesicDistance(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 0.01, Lat: 0}, options)
+	if err != nil {
+		t.Errorf("GeodesicDistance error %v", err)
+		return
+	}
+
+	p, err := SlopeProfile(f, options)
+	if err != nil {
+		t.Errorf("SlopeProfile error %v", err)
+		return
+	}
+	assert.Equal(t, len(p.Segments), 3)
+	assert.True(t, math.Abs(p.Segments[0].Grade-100/h) < 1e-9)
+	assert.True(t, math.Abs(p.Segments[1].Grade+50/h) < 1e-9)
+	assert.True(t, math.IsInf(p.Segments[2].Grade, 1))
+
+	assert.Equal(t, p.Segments[0].Ascent, 100.0)
+	assert.Equal(t, p.Segments[1].Descent, 50.0)
+	assert.Equal(t, p.Segments[2].Ascent, 130.0)
+	assert.Equal(t, p.Ascent, 130.0)
+	assert.Equal(t, p.Descent, 50.0)
+	assert.True(t, math.Abs(p.Distance-2*h) < 1e-6)
+	assert.True(t, math.Abs(p.Length3D-(math.Hypot(h, 100)+math.Hypot(h, 50)+30)) < 1e-6)
+
+	_, err = SlopeProfile(geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}}}, options)
+	assert.True(t, err != nil)
+}
@@ -0,0 +1,81 @@
+package measurement
+
+import (
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// LineOptions contains the options of the functions that walk along the segments of a line.
+type LineOptions struct {
+	// Units of the distances. constants.UnitDefault is the default value
+	Units string
+	// Rhumb measures the segments along rhumb lines, with a constant bearing, instead of great circles
+	Rhumb bool
+}
+
+// RhumbLine calculates the rhumb line between two points and returns it as a LineString Feature.
+// npoints is the number of points of the line, 100 is the default value.
+// When the line crosses the 180th meridian it is split and a MultiLineString Feature is returned instead.
+func RhumbLine(start geometry.Point, end geometry.Point, npoints int, properties map[string]interface{}) (*feature.Feature, error) {
+	if npoints < 2 {
+		npoints = 100
+	}
+
+	distance, err := RhumbDistance(start, end, constants.UnitMeters)
+	if err != nil {
+		return nil, err
+	}
+	origin := []float64{start.Lng, start.Lat}
+	bearing := calculateRhumbBearing(origin, []float64{end.Lng, end.Lat})
+
+	points := []geometry.Point{start}
+	for i := 1; i < npoints-1; i++ {
+		c := calculateRhumbDestination(origin, *distance*float64(i)/float64(npoints-1), bearing, nil)
+		points = append(points, geometry.Point{Lng: c[0], Lat: c[1]})
+	}
+	points = append(points, end)
+
+	return lineFeature(points, properties)
+}
+
+func lineDefaults(options *LineOptions) *LineOptions {
+	o := LineOptions{Units: constants.UnitDefault}
+	if options != nil {
+		if options.Units != "" {
+			o.Units = options.Units
+		}
+		o.Rhumb = options.Rhumb
+	}
+	return &o
+}
+
+// segmentDistance returns the distance between two points along a great circle or a rhumb line.
+func segmentDistance(p1 geometry.Point, p2 geometry.Point, options *LineOptions) (float64, error) {
+	if options.Rhumb {
+		d, err := RhumbDistance(p1, p2, options.Units)
+		if err != nil {
+			return 0.0, err
+		}
+		return *d, nil
+	}
+	return PointDistance(p1, p2, options.Units)
+}
+
+// segmentDestination returns the point at the given distance from the first point towards the second one, along a great
+// circle or a rhumb line.
+func segmentDestination(from geometry.Point, to geometry.Point, distance float64, options *LineOptions) (*geometry.Point, error) {
+	if !options.Rhumb {
+		return Destination(from, distance, PointBearing(from, to), options.Units)
+	}
+	meters, err := conversions.ConvertLength(math.Abs(distance), options.Units, constants.UnitMeters)
+	if err != nil {
+		return nil, err
+	}
+	origin := []float64{from.Lng, from.Lat}
+	c := calculateRhumbDestination(origin, math.Copysign(meters, distance), calculateRhumbBearing(origin, []float64{to.Lng, to.Lat}), nil)
+	return &geometry.Point{Lng: c[0], Lat: c[1]}, nil
+}
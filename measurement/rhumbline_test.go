@@ -0,0 +1,115 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestRhumbLine(t *testing.T) {
+	start := geometry.Point{Lng: -74, Lat: 40}
+	end := geometry.Point{Lng: -9, Lat: 38.7}
+
+	f, err := RhumbLine(start, end, 11, map[string]interface{}{"name": "crossing"})
+	if err != nil {
+		t.Errorf("RhumbLine error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.GeoJSONType, geojson.LineString)
+	assert.Equal(t, f.Properties["name"], "crossing")
+
+	ln, err := f.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+		return
+	}
+	assert.Equal(t, len(ln.Coordinates), 11)
+	assert.Equal(t, ln.Coordinates[0], start)
+	assert.Equal(t, ln.Coordinates[10], end)
+
+	// every point keeps the same bearing to the end
+	bearing, err := RhumbBearing(start, end, false)
+	if err != nil {
+		t.Errorf("RhumbBearing error %v", err)
+		return
+	}
+	for _, p := range ln.Coordinates[1:10] {
+		b, err := RhumbBearing(p, end, false)
+		if err != nil {
+			t.Errorf("RhumbBearing error %v", err)
+			return
+		}
+		assert.True(t, math.Abs(*b-*bearing) < 1e-6)
+	}
+
+	// the rhumb line crosses the 180th meridian
+	f, err = RhumbLine(geometry.Point{Lng: 170, Lat: 10}, geometry.Point{Lng: -170, Lat: 20}, 10, nil)
+	if err != nil {
+		t.Errorf("RhumbLine error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.GeoJSONType, geojson.MultiLineString)
+}
+
+func TestRhumbLength(t *testing.T) {
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: -74, Lat: 40}, {Lng: -9, Lat: 38.7}}}
+
+	rhumb, err := LengthWithOptions(ln, &LineOptions{Units: constants.UnitKilometers, Rhumb: true})
+	if err != nil {
+		t.Errorf("LengthWithOptions error %v", err)
+		return
+	}
+	expected, err := RhumbDistance(ln.Coordinates[0], ln.Coordinates[1], constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("RhumbDistance error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(rhumb-*expected) < 1e-9)
+
+	gc, err := Length(ln, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Length error %v", err)
+		return
+	}
+	assert.True(t, rhumb > gc)
+}
+
+func TestRhumbAlong(t *testing.T) {
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 10, Lat: 10}, {Lng: 20, Lat: 10}}}
+	options := &LineOptions{Units: constants.UnitKilometers, Rhumb: true}
+
+	first, err := RhumbDistance(ln.Coordinates[0], ln.Coordinates[1], constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("RhumbDistance error %v", err)
+		return
+	}
+
+	// the middle of the first segment is on the constant heading
+	p, err := AlongWithOptions(ln, *first/2, options)
+	if err != nil {
+		t.Errorf("AlongWithOptions error %v", err)
+		return
+	}
+	b1, _ := RhumbBearing(ln.Coordinates[0], *p, false)
+	b2, _ := RhumbBearing(*p, ln.Coordinates[1], false)
+	assert.True(t, math.Abs(*b1-*b2) < 1e-6)
+
+	// the second segment follows the parallel
+	p, err = AlongWithOptions(ln, *first+100, options)
+	if err != nil {
+		t.Errorf("AlongWithOptions error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(p.Lat-10) < 1e-9)
+
+	p, err = AlongWithOptions(ln, 1e6, options)
+	if err != nil {
+		t.Errorf("AlongWithOptions error %v", err)
+		return
+	}
+	assert.Equal(t, *p, ln.Coordinates[2])
+}
@@ -0,0 +1,113 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// ShapeMetrics contains the compactness indices of a Polygon or MultiPolygon.
+// The indices are 1 for a circle and get closer to 0 for less compact shapes, except for the Elongation which
+// is 0 for shapes as wide as long and gets closer to 1 for elongated ones.
+type ShapeMetrics struct {
+	// Area in square meters
+	Area float64
+	// Perimeter in meters
+	Perimeter float64
+	// PolsbyPopper is the ratio of the area to the area of a circle with the same perimeter, 4πA/P²
+	PolsbyPopper float64
+	// Schwartzberg is the ratio of the circumference of a circle with the same area to the perimeter, 2π√(A/π)/P
+	Schwartzberg float64
+	// Reock is the ratio of the area to the area of the minimum enclosing circle
+	Reock float64
+	// ConvexHull is the ratio of the area to the area of the convex hull
+	ConvexHull float64
+	// Elongation is 1 - width/length of the minimum-area bounding rectangle
+	Elongation float64
+}
+
+// Perimeter takes a Polygon, MultiPolygon, Feature or FeatureCollection and returns the length of the rings of all
+// its polygons, holes included.
+func Perimeter(t interface{}, units string) (float64, error) {
+	polys, err := polygonsOf(t)
+	if err != nil {
+		return 0.0, err
+	}
+	total := 0.0
+	for _, p := range polys {
+		l, err := Length(p, units)
+		if err != nil {
+			return 0.0, err
+		}
+		total += l
+	}
+	return total, nil
+}
+
+// Shape calculates the compactness indices of a Polygon or MultiPolygon Feature or Geometry.
+// A MultiPolygon is measured as a whole, its convex hull, enclosing circle and bounding rectangle cover all the polygons.
+func Shape(t interface{}) (*ShapeMetrics, error) {
+	polys, err := polygonsOf(t)
+	if err != nil {
+		return nil, err
+	}
+	if len(polys) == 0 {
+		return nil, errors.New("polygon is required")
+	}
+
+	area := 0.0
+	perimeter := 0.0
+	points := []geometry.Point{}
+	for _, p := range polys {
+		a, err := Area(&p)
+		if err != nil {
+			return nil, err
+		}
+		area += a
+		l, err := Length(p, constants.UnitMeters)
+		if err != nil {
+			return nil, err
+		}
+		perimeter += l
+		if len(p.Coordinates) > 0 {
+			points = append(points, p.Coordinates[0].Coordinates...)
+		}
+	}
+	if area == 0 || perimeter == 0 {
+		return nil, errors.New("polygon is degenerate")
+	}
+
+	lp := newLocalPlane(points)
+	projected := []xy{}
+	for _, p := range points {
+		projected = append(projected, lp.forward(p))
+	}
+	hull := convexHull(projected)
+
+	hullRing := []geometry.Point{}
+	for _, h := range hull {
+		hullRing = append(hullRing, lp.inverse(h))
+	}
+	hullRing = append(hullRing, hullRing[0])
+	hullArea, err := Area(&geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: hullRing}}})
+	if err != nil {
+		return nil, err
+	}
+
+	mec := minEnclosingCircle(hull)
+	rect := minAreaRectangle(hull)
+	length := math.Max(rect.width, rect.height)
+	width := math.Min(rect.width, rect.height)
+
+	return &ShapeMetrics{
+		Area:         area,
+		Perimeter:    perimeter,
+		PolsbyPopper: 4 * math.Pi * area / (perimeter * perimeter),
+		Schwartzberg: 2 * math.Pi * math.Sqrt(area/math.Pi) / perimeter,
+		Reock:        area / (math.Pi * mec.radius * mec.radius),
+		ConvexHull:   area / hullArea,
+		Elongation:   1 - width/length,
+	}, nil
+}
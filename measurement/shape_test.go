@@ -0,0 +1,123 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/utils"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func ring(coords ...[]float64) geometry.LineString {
+	pts := []geometry.Point{}
+	for _, c := range coords {
+		pts = append(pts, geometry.Point{Lng: c[0], Lat: c[1]})
+	}
+	return geometry.LineString{Coordinates: pts}
+}
+
+func TestPerimeter(t *testing.T) {
+	gjson, err := utils.LoadJSONFixture(AreaPolygon)
+	if err != nil {
+		t.Errorf("LoadJSONFixture error: %v", err)
+	}
+	f, err := feature.FromJSON(gjson)
+	if err != nil {
+		t.Errorf("FromJSON error: %v", err)
+	}
+	p, err := Perimeter(f, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Perimeter error: %v", err)
+	}
+	poly, err := f.ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error: %v", err)
+	}
+	l, err := Length(*poly, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Length error: %v", err)
+	}
+	assert.Equal(t, p, l)
+
+	mp := geometry.MultiPolygon{Coordinates: []geometry.Polygon{*poly, *poly}}
+	p, err = Perimeter(&mp, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Perimeter error: %v", err)
+	}
+	assert.Equal(t, p, 2*l)
+
+	p, err = Perimeter(*f, constants.UnitDefault)
+	if err != nil {
+		t.Errorf("Perimeter error: %v", err)
+	}
+	assert.Equal(t, p, l)
+}
+
+func TestShapeSquare(t *testing.T) {
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{ring([]float64{0, 0}, []float64{0.01, 0}, []float64{0.01, 0.01}, []float64{0, 0.01}, []float64{0, 0})}}
+
+	s, err := Shape(&poly)
+	if err != nil {
+		t.Errorf("Shape error: %v", err)
+		return
+	}
+	assert.True(t, math.Abs(s.PolsbyPopper-math.Pi/4) < 1e-4)
+	assert.True(t, math.Abs(s.Schwartzberg-math.Sqrt(math.Pi/4)) < 1e-4)
+	assert.True(t, math.Abs(s.Reock-2/math.Pi) < 1e-4)
+	assert.True(t, math.Abs(s.ConvexHull-1) < 1e-6)
+	assert.True(t, s.Elongation < 1e-4)
+
+	// a polygon passed by value
+	v, err := Shape(poly)
+	if err != nil {
+		t.Errorf("Shape error: %v", err)
+		return
+	}
+	assert.Equal(t, v, s)
+}
+
+func TestShapeConcave(t *testing.T) {
+	// three cells of a 2x2 grid
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{ring([]float64{0, 0}, []float64{0.02, 0}, []float64{0.02, 0.01}, []float64{0.01, 0.01}, []float64{0.01, 0.02}, []float64{0, 0.02}, []float64{0, 0})}}
+
+	s, err := Shape(&poly)
+	if err != nil {
+		t.Errorf("Shape error: %v", err)
+		return
+	}
+	assert.True(t, math.Abs(s.ConvexHull-3/3.5) < 1e-4)
+	assert.True(t, s.PolsbyPopper < math.Pi/4)
+}
+
+func TestShapeRotatedRectangle(t *testing.T) {
+	// a 4x1 rectangle rotated by 30 degrees
+	θ := math.Pi / 6
+	u := []float64{0.04 * math.Cos(θ), 0.04 * math.Sin(θ)}
+	v := []float64{-0.01 * math.Sin(θ), 0.01 * math.Cos(θ)}
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{ring(
+		[]float64{0, 0},
+		[]float64{u[0], u[1]},
+		[]float64{u[0] + v[0], u[1] + v[1]},
+		[]float64{v[0], v[1]},
+		[]float64{0, 0},
+	)}}
+
+	s, err := Shape(&poly)
+	if err != nil {
+		t.Errorf("Shape error: %v", err)
+		return
+	}
+	assert.True(t, math.Abs(s.Elongation-0.75) < 1e-3)
+	assert.True(t, math.Abs(s.ConvexHull-1) < 1e-6)
+	assert.True(t, s.Area > 0 && s.Perimeter > 0)
+}
+
+func TestShapeInvalid(t *testing.T) {
+	_, err := Shape(&geometry.MultiPolygon{})
+	if err == nil {
+		t.Errorf("expected an error for an empty MultiPolygon")
+	}
+}
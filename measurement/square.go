@@ -0,0 +1,72 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+	"github.com/tomchavakis/geojson"
+)
+
+// Square takes a bounding box and expands its shorter side so that its width and height have the same real-world
+// length. The width is measured along the middle latitude of the bounding box and the result keeps the same center.
+// A bounding box with West greater than East crosses the antimeridian, the square crosses it too when it extends
+// over it. It returns an error when the square would extend beyond the poles or around the whole globe.
+//
+// Example:
+//
+//	sq, err := Square(geojson.BBOX{West: -20, South: -20, East: -15, North: 0})
+//	poly, err := BBoxPolygon(*sq, "")
+func Square(bbox geojson.BBOX) (*geojson.BBOX, error) {
+	midLat := (bbox.South + bbox.North) / 2
+	east := bbox.East
+	if bbox.West > east {
+		// the bounding box crosses the antimeridian
+		east += 360
+	}
+	midLng := (bbox.West + east) / 2
+
+	horizontal, err := Distance(bbox.West, midLat, bbox.East, midLat, constants.UnitRadians)
+	if err != nil {
+		return nil, err
+	}
+	vertical, err := Distance(bbox.West, bbox.South, bbox.West, bbox.North, constants.UnitRadians)
+	if err != nil {
+		return nil, err
+	}
+
+	if horizontal >= vertical {
+		half := conversions.RadiansToDegrees(horizontal) / 2
+		if midLat-half < -90 || midLat+half > 90 {
+			return nil, errors.New("the square extends beyond the poles")
+		}
+		return &geojson.BBOX{
+			West:  bbox.West,
+			South: midLat - half,
+			East:  bbox.East,
+			North: midLat + half,
+		}, nil
+	}
+
+	// great circle distance between two points of the same latitude φ: d = 2·asin(cos φ·sin(Δλ/2))
+	ratio := math.Sin(vertical/2) / math.Cos(conversions.DegreesToRadians(midLat))
+	if ratio >= 1 {
+		return nil, errors.New("the square extends around the globe")
+	}
+	half := conversions.RadiansToDegrees(math.Asin(ratio))
+	return &geojson.BBOX{
+		West:  wrapLongitude(midLng - half),
+		South: bbox.South,
+		East:  wrapLongitude(midLng + half),
+		North: bbox.North,
+	}, nil
+}
+
+// wrapLongitude returns the longitude in [-180, 180].
+func wrapLongitude(lng float64) float64 {
+	if lng > 180 || lng < -180 {
+		return normalizeLongitude(lng)
+	}
+	return lng
+}
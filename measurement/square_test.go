@@ -0,0 +1,83 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson"
+)
+
+func TestSquare(t *testing.T) {
+	tests := map[string]struct {
+		bbox geojson.BBOX
+	}{
+		"taller than wide": {
+			bbox: geojson.BBOX{West: -20, South: -20, East: -15, North: 0},
+		},
+		"wider than tall": {
+			bbox: geojson.BBOX{West: -10, South: 50, East: 10, North: 55},
+		},
+		"small extent": {
+			bbox: geojson.BBOX{West: 23.70, South: 37.97, East: 23.75, North: 37.98},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sq, err := Square(tt.bbox)
+			if err != nil {
+				t.Errorf("Square error %v", err)
+				return
+			}
+			midLat := (sq.South + sq.North) / 2
+			width, err := Distance(sq.West, midLat, sq.East, midLat, constants.UnitKilometers)
+			if err != nil {
+				t.Errorf("Distance error %v", err)
+			}
+			height, err := Distance(sq.West, sq.South, sq.West, sq.North, constants.UnitKilometers)
+			if err != nil {
+				t.Errorf("Distance error %v", err)
+			}
+			assert.True(t, math.Abs(width-height) < 1e-6)
+
+			// the square contains the original bounding box
+			assert.True(t, sq.West <= tt.bbox.West && sq.East >= tt.bbox.East && sq.South <= tt.bbox.South && sq.North >= tt.bbox.North)
+			assert.True(t, math.Abs((sq.West+sq.East)/2-(tt.bbox.West+tt.bbox.East)/2) < 1e-9)
+			assert.True(t, math.Abs(midLat-(tt.bbox.South+tt.bbox.North)/2) < 1e-9)
+
+			_, err = BBoxPolygon(*sq, "")
+			if err != nil {
+				t.Errorf("BBoxPolygon error %v", err)
+			}
+		})
+	}
+}
+
+func TestSquareAntimeridian(t *testing.T) {
+	// 20 degrees wide across the antimeridian and 40 degrees tall
+	sq, err := Square(geojson.BBOX{West: 170, South: -20, East: -170, North: 20})
+	if err != nil {
+		t.Errorf("Square error %v", err)
+		return
+	}
+	assert.True(t, sq.West > sq.East)
+	assert.True(t, math.Abs(sq.West+sq.East) < 1e-9)
+	assert.True(t, sq.West < 170 && sq.East > -170)
+	width, err := Distance(sq.West, 0, sq.East, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	height, err := Distance(sq.West, sq.South, sq.West, sq.North, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Distance error %v", err)
+	}
+	assert.True(t, math.Abs(width-height) < 1e-6)
+}
+
+func TestSquareOutOfRange(t *testing.T) {
+	// from pole to pole, no parallel is long enough for the width
+	_, err := Square(geojson.BBOX{West: 0, South: -90, East: 1, North: 90})
+	assert.True(t, err != nil)
+}
@@ -0,0 +1,311 @@
+package measurement
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/conversions"
+	meta "github.com/et-soft/turf-go/meta/coordAll"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// StatisticsOptions contains the options of the spatial statistics functions.
+type StatisticsOptions struct {
+	// Weight is the name of the numeric property that weights each feature. Every feature weighs 1 when it is empty
+	Weight string
+	// Tolerance of the geometric median iterations in degrees. 0.001 is the default value
+	Tolerance float64
+	// MaxIterations of the geometric median. 10 is the default value
+	MaxIterations int
+	// Steps is the number of vertices of the circle and the ellipse. 64 is the default value
+	Steps int
+	// Units of the calculated distances. constants.UnitDefault is the default value
+	Units string
+	// Properties of the returned Feature, the calculated properties are added to them
+	Properties map[string]interface{}
+}
+
+// CenterMean takes a FeatureCollection and returns the mean center of all its vertices as a Point Feature.
+// With the Weight option the vertices of each feature are weighted by the property value.
+func CenterMean(fc feature.Collection, options *StatisticsOptions) (*feature.Feature, error) {
+	options = statisticsDefaults(options)
+	excludeWrapCoord := true
+	sumX := 0.0
+	sumY := 0.0
+	sumW := 0.0
+	for i := range fc.Features {
+		w, err := featureWeight(fc.Features[i], options.Weight)
+		if err != nil {
+			return nil, err
+		}
+		coords, err := meta.CoordAll(&fc.Features[i], &excludeWrapCoord)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range coords {
+			sumX += c.Lng * w
+			sumY += c.Lat * w
+			sumW += w
+		}
+	}
+	if sumW == 0 {
+		return nil, errors.New("no weighted coordinates found")
+	}
+	return pointFeature(geometry.Point{Lng: sumX / sumW, Lat: sumY / sumW}, copyProperties(options.Properties))
+}
+
+// CenterMedian takes a FeatureCollection and returns its geometric median, the point that minimizes the weighted
+// sum of the distances to the centroids of the features, as a Point Feature.
+// The median is found with the Weiszfeld iterations starting from the weighted mean center, they stop when the candidate
+// moves less than the Tolerance or after MaxIterations.
+// ref. E. Weiszfeld, "Sur le point pour lequel la somme des distances de n points donnés est minimum", 1937
+func CenterMedian(fc feature.Collection, options *StatisticsOptions) (*feature.Feature, error) {
+	options = statisticsDefaults(options)
+	centroids, weights, err := weightedCentroids(fc, options.Weight)
+	if err != nil {
+		return nil, err
+	}
+
+	candidate := weightedMean(centroids, weights)
+	for i := 0; i < options.MaxIterations; i++ {
+		sumX := 0.0
+		sumY := 0.0
+		sumK := 0.0
+		for j, c := range centroids {
+			d, err := PointDistance(candidate, c, constants.UnitKilometers)
+			if err != nil {
+				return nil, err
+			}
+			if d == 0 {
+				continue
+			}
+			k := weights[j] / d
+			sumX += c.Lng * k
+			sumY += c.Lat * k
+			sumK += k
+		}
+		if sumK == 0 {
+			break
+		}
+		next := geometry.Point{Lng: sumX / sumK, Lat: sumY / sumK}
+		converged := math.Abs(next.Lng-candidate.Lng) < options.Tolerance && math.Abs(next.Lat-candidate.Lat) < options.Tolerance
+		candidate = next
+		if converged {
+			break
+		}
+	}
+	return pointFeature(candidate, copyProperties(options.Properties))
+}
+
+// StandardDistance takes a FeatureCollection and returns the standard distance circle of the centroids of its features
+// around their weighted mean center as a Polygon Feature. The radius is the "standardDistance" property.
+func StandardDistance(fc feature.Collection, options *StatisticsOptions) (*feature.Feature, error) {
+	options = statisticsDefaults(options)
+	centroids, weights, err := weightedCentroids(fc, options.Weight)
+	if err != nil {
+		return nil, err
+	}
+
+	center := weightedMean(centroids, weights)
+	sum := 0.0
+	sumW := 0.0
+	for i, c := range centroids {
+		d, err := PointDistance(center, c, constants.UnitMeters)
+		if err != nil {
+			return nil, err
+		}
+		sum += weights[i] * d * d
+		sumW += weights[i]
+	}
+	sd := math.Sqrt(sum / sumW)
+
+	r, err := conversions.ConvertLength(sd, constants.UnitMeters, options.Units)
+	if err != nil {
+		return nil, err
+	}
+	props := copyProperties(options.Properties)
+	props["standardDistance"] = r
+	props["meanCenterCoordinates"] = []float64{center.Lng, center.Lat}
+	return polygonFeature(ellipseRing(localPlaneAt(center), xy{}, sd, sd, xy{1, 0}, options.Steps), props)
+}
+
+// StandardDeviationalEllipse takes a FeatureCollection and returns the standard deviational ellipse of the centroids of
+// its features as a Polygon Feature. The ellipse is centered on the weighted mean center and its axes are the
+// standard deviations along the principal directions of the centroids.
+// The Feature has the properties "semiMajorAxis", "semiMinorAxis", "angle" (the bearing of the major axis in degrees
+// from True North between 0 and 180), "numberOfFeatures" and "percentageWithinEllipse".
+func StandardDeviationalEllipse(fc feature.Collection, options *StatisticsOptions) (*feature.Feature, error) {
+	options = statisticsDefaults(options)
+	centroids, weights, err := weightedCentroids(fc, options.Weight)
+	if err != nil {
+		return nil, err
+	}
+
+	center := weightedMean(centroids, weights)
+	lp := localPlaneAt(center)
+	projected := []xy{}
+	sxx := 0.0
+	syy := 0.0
+	sxy := 0.0
+	sumW := 0.0
+	for i, c := range centroids {
+		p := lp.forward(c)
+		projected = append(projected, p)
+		sxx += weights[i] * p.x * p.x
+		syy += weights[i] * p.y * p.y
+		sxy += weights[i] * p.x * p.y
+		sumW += weights[i]
+	}
+	sxx /= sumW
+	syy /= sumW
+	sxy /= sumW
+
+	// eigenvalues and the major eigenvector of the covariance matrix
+	mean := (sxx + syy) / 2
+	diff := math.Sqrt((sxx-syy)*(sxx-syy)/4 + sxy*sxy)
+	λ1 := mean + diff
+	λ2 := math.Max(mean-diff, 0)
+	major := xy{1, 0}
+	if sxy != 0 {
+		major = xy{λ1 - syy, sxy}
+		l := major.norm()
+		major = xy{major.x / l, major.y / l}
+	} else if syy > sxx {
+		major = xy{0, 1}
+	}
+	a := math.Sqrt(λ1)
+	b := math.Sqrt(λ2)
+
+	within := 0
+	minor := xy{-major.y, major.x}
+	for _, p := range projected {
+		u := p.dot(major)
+		v := p.dot(minor)
+		if (a > 0 && b > 0 && (u*u)/(a*a)+(v*v)/(b*b) <= 1) || (b == 0 && v == 0 && math.Abs(u) <= a) {
+			within++
+		}
+	}
+
+	semiMajor, err := conversions.ConvertLength(a, constants.UnitMeters, options.Units)
+	if err != nil {
+		return nil, err
+	}
+	semiMinor, err := conversions.ConvertLength(b, constants.UnitMeters, options.Units)
+	if err != nil {
+		return nil, err
+	}
+
+	props := copyProperties(options.Properties)
+	props["semiMajorAxis"] = semiMajor
+	props["semiMinorAxis"] = semiMinor
+	props["angle"] = math.Mod(conversions.RadiansToDegrees(math.Atan2(major.x, major.y))+360, 180)
+	props["numberOfFeatures"] = len(centroids)
+	props["percentageWithinEllipse"] = 100 * float64(within) / float64(len(centroids))
+	props["meanCenterCoordinates"] = []float64{center.Lng, center.Lat}
+	return polygonFeature(ellipseRing(lp, xy{}, a, b, major, options.Steps), props)
+}
+
+func statisticsDefaults(options *StatisticsOptions) *StatisticsOptions {
+	o := StatisticsOptions{Tolerance: 0.001, MaxIterations: 10, Steps: 64, Units: constants.UnitDefault}
+	if options != nil {
+		o.Weight = options.Weight
+		o.Properties = options.Properties
+		if options.Tolerance > 0 {
+			o.Tolerance = options.Tolerance
+		}
+		if options.MaxIterations > 0 {
+			o.MaxIterations = options.MaxIterations
+		}
+		if options.Steps > 2 {
+			o.Steps = options.Steps
+		}
+		if options.Units != "" {
+			o.Units = options.Units
+		}
+	}
+	return &o
+}
+
+// featureWeight returns the value of the weight property of a feature, 1 when there is no weight property.
+func featureWeight(f feature.Feature, weight string) (float64, error) {
+	if weight == "" {
+		return 1.0, nil
+	}
+	var w float64
+	switch v := f.Properties[weight].(type) {
+	case float64:
+		w = v
+	case float32:
+		w = float64(v)
+	case int:
+		w = float64(v)
+	case int64:
+		w = float64(v)
+	case int32:
+		w = float64(v)
+	default:
+		return 0.0, fmt.Errorf("weight property %s must be a number", weight)
+	}
+	if w < 0 {
+		return 0.0, fmt.Errorf("weight property %s must not be negative", weight)
+	}
+	return w, nil
+}
+
+// weightedCentroids returns the centroids of the features with a positive weight and their weights.
+func weightedCentroids(fc feature.Collection, weight string) ([]geometry.Point, []float64, error) {
+	excludeWrapCoord := true
+	centroids := []geometry.Point{}
+	weights := []float64{}
+	for i := range fc.Features {
+		w, err := featureWeight(fc.Features[i], weight)
+		if err != nil {
+			return nil, nil, err
+		}
+		if w == 0 {
+			continue
+		}
+		coords, err := meta.CoordAll(&fc.Features[i], &excludeWrapCoord)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(coords) == 0 {
+			continue
+		}
+		centroids = append(centroids, weightedMean(coords, nil))
+		weights = append(weights, w)
+	}
+	if len(centroids) == 0 {
+		return nil, nil, errors.New("no weighted features found")
+	}
+	return centroids, weights, nil
+}
+
+// weightedMean returns the mean of the points, every point weighs 1 when weights is nil.
+func weightedMean(points []geometry.Point, weights []float64) geometry.Point {
+	sumX := 0.0
+	sumY := 0.0
+	sumW := 0.0
+	for i, p := range points {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		sumX += p.Lng * w
+		sumY += p.Lat * w
+		sumW += w
+	}
+	return geometry.Point{Lng: sumX / sumW, Lat: sumY / sumW}
+}
+
+func pointFeature(p geometry.Point, properties map[string]interface{}) (*feature.Feature, error) {
+	g := geometry.Geometry{
+		GeoJSONType: geojson.Point,
+		Coordinates: []float64{p.Lng, p.Lat},
+	}
+	return feature.New(g, []float64{p.Lng, p.Lat, p.Lng, p.Lat}, properties, "")
+}
@@ -0,0 +1,124 @@
+package measurement
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func pointCollection(t *testing.T, weights []interface{}, coords ...[]float64) feature.Collection {
+	features := []feature.Feature{}
+	for i, c := range coords {
+		props := map[string]interface{}{}
+		if weights != nil {
+			props["weight"] = weights[i]
+		}
+		f, err := feature.New(geometry.Geometry{GeoJSONType: geojson.Point, Coordinates: c}, nil, props, "")
+		if err != nil {
+			t.Fatalf("feature error %v", err)
+		}
+		features = append(features, *f)
+	}
+	fc, err := feature.NewFeatureCollection(features)
+	if err != nil {
+		t.Fatalf("feature collection error %v", err)
+	}
+	return *fc
+}
+
+func TestCenterMean(t *testing.T) {
+	fc := pointCollection(t, nil, []float64{0, 0}, []float64{2, 0}, []float64{2, 2}, []float64{0, 2})
+	f, err := CenterMean(fc, nil)
+	if err != nil {
+		t.Errorf("CenterMean error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.Coordinates, []float64{1, 1})
+
+	weighted := pointCollection(t, []interface{}{3, 1.0, 0, 0}, []float64{0, 0}, []float64{4, 0}, []float64{2, 2}, []float64{0, 2})
+	f, err = CenterMean(weighted, &StatisticsOptions{Weight: "weight", Properties: map[string]interface{}{"name": "mean"}})
+	if err != nil {
+		t.Errorf("CenterMean error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.Coordinates, []float64{1, 0})
+	assert.Equal(t, f.Properties["name"], "mean")
+
+	invalid := pointCollection(t, []interface{}{1, "heavy"}, []float64{0, 0}, []float64{1, 1})
+	_, err = CenterMean(invalid, &StatisticsOptions{Weight: "weight"})
+	assert.True(t, err != nil)
+
+	negative := pointCollection(t, []interface{}{1, -1}, []float64{0, 0}, []float64{1, 1})
+	_, err = CenterMean(negative, &StatisticsOptions{Weight: "weight"})
+	assert.True(t, err != nil)
+}
+
+func TestCenterMedian(t *testing.T) {
+	// the outlier pulls the mean center but not the median
+	fc := pointCollection(t, nil, []float64{0, 0}, []float64{0.01, 0}, []float64{0, 0.01}, []float64{0.01, 0.01}, []float64{1, 1})
+	f, err := CenterMedian(fc, &StatisticsOptions{Tolerance: 1e-7, MaxIterations: 100})
+	if err != nil {
+		t.Errorf("CenterMedian error %v", err)
+		return
+	}
+	c := f.Geometry.Coordinates.([]float64)
+	assert.True(t, c[0] > 0.005 && c[0] < 0.02)
+	assert.True(t, c[1] > 0.005 && c[1] < 0.02)
+
+	mean, err := CenterMean(fc, nil)
+	if err != nil {
+		t.Errorf("CenterMean error %v", err)
+		return
+	}
+	assert.True(t, mean.Geometry.Coordinates.([]float64)[0] > 0.2)
+}
+
+func TestStandardDistance(t *testing.T) {
+	fc := pointCollection(t, nil, []float64{0.01, 0}, []float64{-0.01, 0}, []float64{0, 0.01}, []float64{0, -0.01})
+	f, err := StandardDistance(fc, &StatisticsOptions{Units: constants.UnitMeters})
+	if err != nil {
+		t.Errorf("StandardDistance error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.GeoJSONType, geojson.Polygon)
+	degree := constants.EarthRadius * math.Pi / 180
+	assert.True(t, math.Abs(f.Properties["standardDistance"].(float64)-0.01*degree) < 1)
+
+	poly, err := f.ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error %v", err)
+		return
+	}
+	assert.Equal(t, len(poly.Coordinates[0].Coordinates), 65)
+}
+
+func TestStandardDeviationalEllipse(t *testing.T) {
+	// points spread along the north-east diagonal
+	fc := pointCollection(t, nil,
+		[]float64{-0.02, -0.02}, []float64{-0.01, -0.01}, []float64{0, 0}, []float64{0.01, 0.01}, []float64{0.02, 0.02},
+		[]float64{0.001, -0.001}, []float64{-0.001, 0.001},
+	)
+	f, err := StandardDeviationalEllipse(fc, &StatisticsOptions{Units: constants.UnitMeters, Steps: 32})
+	if err != nil {
+		t.Errorf("StandardDeviationalEllipse error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.GeoJSONType, geojson.Polygon)
+	assert.True(t, math.Abs(f.Properties["angle"].(float64)-45) < 0.1)
+	assert.True(t, f.Properties["semiMajorAxis"].(float64) > f.Properties["semiMinorAxis"].(float64))
+	assert.Equal(t, f.Properties["numberOfFeatures"], 7)
+	p := f.Properties["percentageWithinEllipse"].(float64)
+	assert.True(t, p > 0 && p < 100)
+
+	poly, err := f.ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error %v", err)
+		return
+	}
+	assert.Equal(t, len(poly.Coordinates[0].Coordinates), 33)
+}
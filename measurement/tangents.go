@@ -0,0 +1,148 @@
+package measurement
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// PolygonTangents finds the tangents of a Polygon or MultiPolygon from a Point.
+// It returns a FeatureCollection with the right and the left tangent vertices, in this order, as seen from the point.
+// A vertex is a tangent when both its neighbours are on the same side of the line from the point and the line doesn't
+// cross the exterior rings, the polygon lies on the left of the right tangent and on the right of the left tangent.
+// Among several tangents on a side the outermost one is returned, the nearest one when they are collinear.
+// The point must be outside of the polygon, it can be in a concave notch.
+func PolygonTangents(pt geometry.Point, polygon interface{}) (*feature.Collection, error) {
+	polys, err := polygonsOf(polygon)
+	if err != nil {
+		return nil, err
+	}
+
+	// the holes are inside the exterior rings, they can't touch a tangent
+	rings := [][]geometry.Point{}
+	for _, poly := range polys {
+		if len(poly.Coordinates) == 0 || len(poly.Coordinates[0].Coordinates) < 4 {
+			continue
+		}
+		r := poly.Coordinates[0].Coordinates
+		if r[0] == r[len(r)-1] {
+			r = r[:len(r)-1]
+		}
+		for _, c := range r {
+			if c == pt {
+				return nil, errors.New("point is a vertex of the polygon")
+			}
+		}
+		if inRing(pt, r) {
+			return nil, errors.New("point must be outside of the polygon")
+		}
+		rings = append(rings, r)
+	}
+	if len(rings) == 0 {
+		return nil, errors.New("polygon is required")
+	}
+
+	o := xy{pt.Lng, pt.Lat}
+	rights := []geometry.Point{}
+	lefts := []geometry.Point{}
+	for _, r := range rings {
+		n := len(r)
+		for i, c := range r {
+			v := xy{c.Lng, c.Lat}.sub(o)
+			prev := v.cross(xy{r[(i+n-1)%n].Lng, r[(i+n-1)%n].Lat}.sub(o))
+			next := v.cross(xy{r[(i+1)%n].Lng, r[(i+1)%n].Lat}.sub(o))
+			right := prev >= 0 && next >= 0 && (prev > 0 || next > 0)
+			left := prev <= 0 && next <= 0 && (prev < 0 || next < 0)
+			if (!right && !left) || !visible(pt, c, rings) {
+				continue
+			}
+			if right {
+				rights = append(rights, c)
+			} else {
+				lefts = append(lefts, c)
+			}
+		}
+	}
+	if len(rights) == 0 || len(lefts) == 0 {
+		// the point is on the boundary
+		return nil, errors.New("no tangent is visible from the point")
+	}
+	rtan := outermost(pt, rights, -1)
+	ltan := outermost(pt, lefts, 1)
+
+	rf, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.Point,
+		Coordinates: []float64{rtan.Lng, rtan.Lat},
+	}, nil, map[string]interface{}{"tangent": "right"}, "")
+	if err != nil {
+		return nil, err
+	}
+	lf, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.Point,
+		Coordinates: []float64{ltan.Lng, ltan.Lat},
+	}, nil, map[string]interface{}{"tangent": "left"}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return feature.NewFeatureCollection([]feature.Feature{*rf, *lf})
+}
+
+// outermost returns the tangent vertex with the fewest others beyond it, clockwise for the right tangents (side -1)
+// and counter-clockwise for the left ones (side 1), the nearest one among collinear vertices.
+func outermost(pt geometry.Point, vertices []geometry.Point, side float64) geometry.Point {
+	o := xy{pt.Lng, pt.Lat}
+	best := 0
+	bestBeyond := -1
+	for i, c := range vertices {
+		v := xy{c.Lng, c.Lat}.sub(o)
+		beyond := 0
+		for _, other := range vertices {
+			if side*v.cross(xy{other.Lng, other.Lat}.sub(o)) > 0 {
+				beyond++
+			}
+		}
+		if bestBeyond < 0 || beyond < bestBeyond || (beyond == bestBeyond && v.norm() < xy{vertices[best].Lng, vertices[best].Lat}.sub(o).norm()) {
+			best, bestBeyond = i, beyond
+		}
+	}
+	return vertices[best]
+}
+
+// visible reports whether the segment from pt to the vertex c doesn't cross the rings.
+func visible(pt geometry.Point, c geometry.Point, rings [][]geometry.Point) bool {
+	a := xy{pt.Lng, pt.Lat}
+	b := xy{c.Lng, c.Lat}
+	d := b.sub(a)
+	for _, r := range rings {
+		n := len(r)
+		for i := range r {
+			p, q := r[i], r[(i+1)%n]
+			if p == c || q == c {
+				continue
+			}
+			e1 := xy{p.Lng, p.Lat}
+			e2 := xy{q.Lng, q.Lat}
+			// the edge ends are on opposite sides of the segment and the segment ends on opposite sides of the edge
+			if d.cross(e1.sub(a))*d.cross(e2.sub(a)) < 0 && e2.sub(e1).cross(a.sub(e1))*e2.sub(e1).cross(b.sub(e1)) < 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// inRing reports whether the point is inside the ring with the ray casting test.
+func inRing(p geometry.Point, ring []geometry.Point) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a := ring[i]
+		b := ring[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) && p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
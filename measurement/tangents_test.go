@@ -0,0 +1,91 @@
+package measurement
+
+import (
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestPolygonTangents(t *testing.T) {
+	poly, err := feature.FromJSON("{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": [[[11, 0], [22, 4], [31, 0], [31, 11], [21, 15], [11, 11], [11, 0]]] } }")
+	if err != nil {
+		t.Errorf("FromJSON error %v", err)
+		return
+	}
+
+	tangents, err := PolygonTangents(geometry.Point{Lng: 61, Lat: 5}, poly)
+	if err != nil {
+		t.Errorf("PolygonTangents error %v", err)
+		return
+	}
+	assert.Equal(t, len(tangents.Features), 2)
+	assert.Equal(t, tangents.Features[0].Geometry.Coordinates, []float64{21, 15})
+	assert.Equal(t, tangents.Features[0].Properties["tangent"], "right")
+	assert.Equal(t, tangents.Features[1].Geometry.Coordinates, []float64{31, 0})
+	assert.Equal(t, tangents.Features[1].Properties["tangent"], "left")
+}
+
+func TestPolygonTangentsMultiPolygon(t *testing.T) {
+	mp := geometry.MultiPolygon{
+		Coordinates: []geometry.Polygon{
+			{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 2}, {Lng: 0, Lat: 0}}}}},
+			{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{{Lng: 4, Lat: 4}, {Lng: 6, Lat: 4}, {Lng: 6, Lat: 6}, {Lng: 4, Lat: 6}, {Lng: 4, Lat: 4}}}}},
+		},
+	}
+
+	// (2, 0) and (0, 0) are both on the left tangent, the nearest vertex is returned
+	tangents, err := PolygonTangents(geometry.Point{Lng: 10, Lat: 0}, &mp)
+	if err != nil {
+		t.Errorf("PolygonTangents error %v", err)
+		return
+	}
+	assert.Equal(t, tangents.Features[0].Geometry.Coordinates, []float64{6, 6})
+	assert.Equal(t, tangents.Features[1].Geometry.Coordinates, []float64{2, 0})
+}
+
+func TestPolygonTangentsConcave(t *testing.T) {
+	// a U shape, the point is in the notch, outside of the polygon but inside of its convex hull
+	f, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.Polygon,
+		Coordinates: [][][]float64{{{0, 0}, {3, 0}, {3, 3}, {2, 3}, {2, 1}, {1, 1}, {1, 3}, {0, 3}, {0, 0}}},
+	}, nil, nil, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+
+	// a Feature passed by value
+	tangents, err := PolygonTangents(geometry.Point{Lng: 1.5, Lat: 2}, *f)
+	if err != nil {
+		t.Errorf("PolygonTangents error %v", err)
+		return
+	}
+	assert.Equal(t, tangents.Features[0].Geometry.Coordinates, []float64{1, 3})
+	assert.Equal(t, tangents.Features[1].Geometry.Coordinates, []float64{2, 3})
+
+	// from outside of the convex hull the notch is hidden
+	tangents, err = PolygonTangents(geometry.Point{Lng: 1.5, Lat: -2}, f)
+	if err != nil {
+		t.Errorf("PolygonTangents error %v", err)
+		return
+	}
+	assert.Equal(t, tangents.Features[0].Geometry.Coordinates, []float64{3, 0})
+	assert.Equal(t, tangents.Features[1].Geometry.Coordinates, []float64{0, 0})
+}
+
+func TestPolygonTangentsInside(t *testing.T) {
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 2}, {Lng: 0, Lat: 0}}}}}
+
+	_, err := PolygonTangents(geometry.Point{Lng: 1, Lat: 1}, &poly)
+	if err == nil {
+		t.Errorf("expected an error for a point inside the polygon")
+	}
+
+	_, err = PolygonTangents(geometry.Point{Lng: 2, Lat: 2}, &poly)
+	if err == nil {
+		t.Errorf("expected an error for a point on a vertex of the polygon")
+	}
+}
@@ -0,0 +1,179 @@
+package meta
+
+import (
+	"errors"
+	"math"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// SegmentEachFn is called for every 2-vertex segment with the indices of its feature, of the part of a Multi* geometry,
+// of the ring of a polygon and of the segment in its line or ring. Returning false stops the iteration.
+type SegmentEachFn func(segment feature.Feature, featureIndex int, multiFeatureIndex int, geometryIndex int, segmentIndex int) bool
+
+// SegmentReduceFn is called for every 2-vertex segment with the value returned for the previous segment and the same
+// indices as SegmentEachFn. It returns the value passed to the next segment.
+type SegmentReduceFn func(previousValue interface{}, segment feature.Feature, featureIndex int, multiFeatureIndex int, geometryIndex int, segmentIndex int) interface{}
+
+// SegmentEach iterates over the 2-vertex segments of the lines and polygon rings of any GeoJSON object.
+// geojson can be a FeatureCollection | Feature | Geometry | GeometryCollection, the geometries of a GeometryCollection
+// have their own featureIndex. Every segment is a LineString Feature with the properties of its feature, the
+// points have no segments.
+// ref. http://turfjs.org/docs/#segmentEach
+func SegmentEach(geojson interface{}, callbackFn SegmentEachFn) error {
+	if geojson == nil {
+		return errors.New("geojson is empty")
+	}
+	switch gtp := geojson.(type) {
+	case *geometry.Point, *geometry.MultiPoint:
+		return nil
+	case *geometry.LineString:
+		_, err := segmentEachParts([][]geometry.LineString{{*gtp}}, 0, nil, callbackFn)
+		return err
+	case *geometry.MultiLineString:
+		_, err := segmentEachParts(multiLineStringParts(*gtp), 0, nil, callbackFn)
+		return err
+	case *geometry.Polygon:
+		_, err := segmentEachParts([][]geometry.LineString{gtp.Coordinates}, 0, nil, callbackFn)
+		return err
+	case *geometry.MultiPolygon:
+		_, err := segmentEachParts(multiPolygonParts(*gtp), 0, nil, callbackFn)
+		return err
+	case *geometry.Geometry:
+		parts, err := geometryParts(gtp)
+		if err != nil {
+			return err
+		}
+		_, err = segmentEachParts(parts, 0, nil, callbackFn)
+		return err
+	case *feature.Feature:
+		parts, err := geometryParts(&gtp.Geometry)
+		if err != nil {
+			return err
+		}
+		_, err = segmentEachParts(parts, 0, gtp.Properties, callbackFn)
+		return err
+	case *feature.Collection:
+		for i := range gtp.Features {
+			parts, err := geometryParts(&gtp.Features[i].Geometry)
+			if err != nil {
+				return err
+			}
+			more, err := segmentEachParts(parts, i, gtp.Features[i].Properties, callbackFn)
+			if err != nil || !more {
+				return err
+			}
+		}
+		return nil
+	case *geometry.Collection:
+		for i := range gtp.Geometries {
+			parts, err := geometryParts(&gtp.Geometries[i])
+			if err != nil {
+				return err
+			}
+			more, err := segmentEachParts(parts, i, nil, callbackFn)
+			if err != nil || !more {
+				return err
+			}
+		}
+		return nil
+	}
+	return errors.New("unknown geojson type")
+}
+
+// SegmentReduce reduces the 2-vertex segments of any GeoJSON object to a single value, like an array reduce.
+// The first segment receives the initialValue.
+// ref. http://turfjs.org/docs/#segmentReduce
+func SegmentReduce(geojson interface{}, callbackFn SegmentReduceFn, initialValue interface{}) (interface{}, error) {
+	previousValue := initialValue
+	err := SegmentEach(geojson, func(segment feature.Feature, featureIndex int, multiFeatureIndex int, geometryIndex int, segmentIndex int) bool {
+		previousValue = callbackFn(previousValue, segment, featureIndex, multiFeatureIndex, geometryIndex, segmentIndex)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return previousValue, nil
+}
+
+// segmentEachParts calls the callback for the segments of the parts of a geometry, parts[multiFeatureIndex][geometryIndex]
+// is a line or a ring. It returns false when the callback stops the iteration and the error of a segment Feature.
+func segmentEachParts(parts [][]geometry.LineString, featureIndex int, properties map[string]interface{}, callbackFn SegmentEachFn) (bool, error) {
+	for multiFeatureIndex, part := range parts {
+		for geometryIndex, line := range part {
+			for segmentIndex := 0; segmentIndex < len(line.Coordinates)-1; segmentIndex++ {
+				segment, err := segmentFeature(line.Coordinates[segmentIndex], line.Coordinates[segmentIndex+1], properties)
+				if err != nil {
+					return false, err
+				}
+				if !callbackFn(*segment, featureIndex, multiFeatureIndex, geometryIndex, segmentIndex) {
+					return false, nil
+				}
+			}
+		}
+	}
+	return true, nil
+}
+
+func segmentFeature(a geometry.Point, b geometry.Point, properties map[string]interface{}) (*feature.Feature, error) {
+	props := map[string]interface{}{}
+	for k, v := range properties {
+		props[k] = v
+	}
+	g := geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: [][]float64{{a.Lng, a.Lat}, {b.Lng, b.Lat}},
+	}
+	bbox := []float64{math.Min(a.Lng, b.Lng), math.Min(a.Lat, b.Lat), math.Max(a.Lng, b.Lng), math.Max(a.Lat, b.Lat)}
+	return feature.New(g, bbox, props, "")
+}
+
+func geometryParts(g *geometry.Geometry) ([][]geometry.LineString, error) {
+	switch g.GeoJSONType {
+	case geojson.Point, geojson.MultiPoint:
+		return nil, nil
+	case geojson.LineString:
+		ln, err := g.ToLineString()
+		if err != nil {
+			return nil, err
+		}
+		return [][]geometry.LineString{{*ln}}, nil
+	case geojson.MultiLineString:
+		mln, err := g.ToMultiLineString()
+		if err != nil {
+			return nil, err
+		}
+		return multiLineStringParts(*mln), nil
+	case geojson.Polygon:
+		poly, err := g.ToPolygon()
+		if err != nil {
+			return nil, err
+		}
+		return [][]geometry.LineString{poly.Coordinates}, nil
+	case geojson.MultiPolygon:
+		multiPoly, err := g.ToMultiPolygon()
+		if err != nil {
+			return nil, err
+		}
+		return multiPolygonParts(*multiPoly), nil
+	}
+	return nil, errors.New("unknown geometry type")
+}
+
+func multiLineStringParts(m geometry.MultiLineString) [][]geometry.LineString {
+	parts := [][]geometry.LineString{}
+	for _, l := range m.Coordinates {
+		parts = append(parts, []geometry.LineString{l})
+	}
+	return parts
+}
+
+func multiPolygonParts(mp geometry.MultiPolygon) [][]geometry.LineString {
+	parts := [][]geometry.LineString{}
+	for _, p := range mp.Coordinates {
+		parts = append(parts, p.Coordinates)
+	}
+	return parts
+}
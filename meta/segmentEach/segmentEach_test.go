@@ -0,0 +1,89 @@
+package meta
+
+import (
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestSegmentEachLineString(t *testing.T) {
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}}}
+
+	segments := []feature.Feature{}
+	indices := []int{}
+	err := SegmentEach(&ln, func(segment feature.Feature, featureIndex int, multiFeatureIndex int, geometryIndex int, segmentIndex int) bool {
+		segments = append(segments, segment)
+		indices = append(indices, segmentIndex)
+		return true
+	})
+	if err != nil {
+		t.Errorf("SegmentEach error %v", err)
+	}
+	assert.Equal(t, len(segments), 2)
+	assert.Equal(t, indices, []int{0, 1})
+	assert.Equal(t, segments[1].Geometry.Coordinates, [][]float64{{1, 0}, {1, 1}})
+	assert.Equal(t, segments[1].Bbox, []float64{1, 0, 1, 1})
+}
+
+func TestSegmentEachFeatureCollection(t *testing.T) {
+	json := "{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"point\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0, 0] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"polygon\" }, \"geometry\": { \"type\": \"MultiPolygon\", \"coordinates\": [" +
+		"[[[0, 0], [2, 0], [2, 2], [0, 0]], [[0.5, 0.2], [1, 0.2], [1, 0.5], [0.5, 0.2]]]," +
+		"[[[5, 5], [6, 5], [6, 6], [5, 5]]]] } }]}"
+	fc, err := feature.CollectionFromJSON(json)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+		return
+	}
+
+	type indices struct {
+		feature      int
+		multiFeature int
+		geometry     int
+		segment      int
+	}
+	visited := []indices{}
+	err = SegmentEach(fc, func(segment feature.Feature, featureIndex int, multiFeatureIndex int, geometryIndex int, segmentIndex int) bool {
+		assert.Equal(t, segment.Properties["name"], "polygon")
+		visited = append(visited, indices{featureIndex, multiFeatureIndex, geometryIndex, segmentIndex})
+		return true
+	})
+	if err != nil {
+		t.Errorf("SegmentEach error %v", err)
+	}
+	assert.Equal(t, len(visited), 9)
+	assert.Equal(t, visited[0], indices{1, 0, 0, 0})
+	assert.Equal(t, visited[4], indices{1, 0, 1, 1})
+	assert.Equal(t, visited[8], indices{1, 1, 0, 2})
+
+	// the iteration stops when the callback returns false
+	count := 0
+	err = SegmentEach(fc, func(segment feature.Feature, featureIndex int, multiFeatureIndex int, geometryIndex int, segmentIndex int) bool {
+		count++
+		return count < 2
+	})
+	if err != nil {
+		t.Errorf("SegmentEach error %v", err)
+	}
+	assert.Equal(t, count, 2)
+}
+
+func TestSegmentReduce(t *testing.T) {
+	poly := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 2}, {Lng: 0, Lat: 0},
+	}}}}
+
+	total, err := SegmentReduce(&poly, func(previousValue interface{}, segment feature.Feature, featureIndex int, multiFeatureIndex int, geometryIndex int, segmentIndex int) interface{} {
+		return previousValue.(int) + 1
+	}, 0)
+	if err != nil {
+		t.Errorf("SegmentReduce error %v", err)
+	}
+	assert.Equal(t, total, 4)
+
+	_, err = SegmentReduce(nil, nil, 0)
+	assert.True(t, err != nil)
+}
@@ -0,0 +1,112 @@
+package misc
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// Densify takes a LineString or MultiLineString, Geometry or Feature and inserts points along the great circle of
+// every segment longer than maxSegmentLength, so that no segment of the returned line is longer than it.
+// The segments are divided into equal parts, the original vertices are kept.
+// It returns a Feature of the same type with the properties of the line.
+func Densify(line interface{}, maxSegmentLength float64, units string) (*feature.Feature, error) {
+	if maxSegmentLength <= 0 {
+		return nil, errors.New("max segment length must be greater than 0")
+	}
+	lines, err := lineStrings(line)
+	if err != nil {
+		return nil, err
+	}
+
+	densified := [][]geometry.Point{}
+	all := []geometry.Point{}
+	for _, l := range lines {
+		points := []geometry.Point{}
+		for i, c := range l.Coordinates {
+			if i > 0 {
+				prev := l.Coordinates[i-1]
+				d, err := measurement.PointDistance(prev, c, units)
+				if err != nil {
+					return nil, err
+				}
+				n := int(math.Ceil(d / maxSegmentLength))
+				for k := 1; k < n; k++ {
+					p, err := measurement.IntermediatePoint(prev, c, float64(k)/float64(n))
+					if err != nil {
+						return nil, err
+					}
+					points = append(points, *p)
+				}
+			}
+			points = append(points, c)
+		}
+		densified = append(densified, points)
+		all = append(all, points...)
+	}
+
+	if lineType(line) != geojson.MultiLineString {
+		return lineFeature(densified[0], properties(line))
+	}
+	coords := [][][]float64{}
+	for _, points := range densified {
+		coords = append(coords, pointsToCoords(points))
+	}
+	g := geometry.Geometry{
+		GeoJSONType: geojson.MultiLineString,
+		Coordinates: coords,
+	}
+	return feature.New(g, bbox(all), properties(line), "")
+}
+
+// Resample takes a LineString or MultiLineString, Geometry or Feature and returns a FeatureCollection of the points at
+// every interval along the line, starting from its first vertex. The interval is measured in the given units.
+// The parts of a MultiLineString are walked one after the other. Every point has the "distance" property, its
+// distance along the line.
+func Resample(line interface{}, interval float64, units string) (*feature.Collection, error) {
+	if interval <= 0 {
+		return nil, errors.New("interval must be greater than 0")
+	}
+	lines, err := lineStrings(line)
+	if err != nil {
+		return nil, err
+	}
+
+	features := []feature.Feature{}
+	next := 0.0
+	travelled := 0.0
+	for _, l := range lines {
+		for i := 0; i < len(l.Coordinates)-1; i++ {
+			a := l.Coordinates[i]
+			b := l.Coordinates[i+1]
+			d, err := measurement.PointDistance(a, b, units)
+			if err != nil {
+				return nil, err
+			}
+			bearing := measurement.PointBearing(a, b)
+			for next <= travelled+d {
+				p := a
+				if offset := next - travelled; offset > 0 {
+					dest, err := measurement.Destination(a, offset, bearing, units)
+					if err != nil {
+						return nil, err
+					}
+					p = *dest
+				}
+				f, err := pointFeature(p, map[string]interface{}{"distance": next})
+				if err != nil {
+					return nil, err
+				}
+				features = append(features, *f)
+				// multiply instead of adding to avoid accumulating rounding errors
+				next = interval * float64(len(features))
+			}
+			travelled += d
+		}
+	}
+	return feature.NewFeatureCollection(features)
+}
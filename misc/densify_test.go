@@ -0,0 +1,94 @@
+package misc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestDensify(t *testing.T) {
+	f, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: [][]float64{{0, 0}, {0.05, 0}, {0.05, 0.001}},
+	}, nil, map[string]interface{}{"name": "track"}, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+
+	d, err := Densify(f, 1, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Densify error %v", err)
+		return
+	}
+	assert.Equal(t, d.Properties["name"], "track")
+	ln, err := d.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+		return
+	}
+	// 5.56 km in 6 parts plus the short last segment
+	assert.Equal(t, len(ln.Coordinates), 8)
+	for i := 1; i < len(ln.Coordinates); i++ {
+		l, err := measurement.PointDistance(ln.Coordinates[i-1], ln.Coordinates[i], constants.UnitKilometers)
+		if err != nil {
+			t.Errorf("PointDistance error %v", err)
+			return
+		}
+		assert.True(t, l <= 1)
+	}
+	assert.Equal(t, ln.Coordinates[6], geometry.Point{Lng: 0.05, Lat: 0})
+
+	ml := geometry.MultiLineString{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0.02, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 1, Lat: 0}, {Lng: 1.02, Lat: 0}}},
+	}}
+	d, err = Densify(ml, 1, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Densify error %v", err)
+		return
+	}
+	assert.Equal(t, d.Geometry.GeoJSONType, geojson.MultiLineString)
+	assert.Equal(t, len(d.Geometry.Coordinates.([][][]float64)[1]), 4)
+}
+
+func TestResample(t *testing.T) {
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0.03, Lat: 0}, {Lng: 0.03, Lat: 0.03}}}
+	length, err := measurement.Length(ln, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Length error %v", err)
+		return
+	}
+
+	fc, err := Resample(ln, 0.5, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Resample error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), int(math.Floor(length/0.5))+1)
+	assert.Equal(t, fc.Features[0].Geometry.Coordinates, []float64{0, 0})
+
+	for i, f := range fc.Features {
+		assert.True(t, math.Abs(f.Properties["distance"].(float64)-0.5*float64(i)) < 1e-9)
+		p, err := f.Geometry.ToPoint()
+		if err != nil {
+			t.Errorf("ToPoint error %v", err)
+			return
+		}
+		expected, err := measurement.Along(ln, 0.5*float64(i), constants.UnitKilometers)
+		if err != nil {
+			t.Errorf("Along error %v", err)
+			return
+		}
+		assert.True(t, math.Abs(p.Lng-expected.Lng) < 1e-9 && math.Abs(p.Lat-expected.Lat) < 1e-9)
+	}
+
+	_, err = Resample(ln, 0, constants.UnitKilometers)
+	assert.True(t, err != nil)
+}
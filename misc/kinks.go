@@ -0,0 +1,353 @@
+package misc
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// crossing is a self-intersection between two segments of the lines or rings of a geometry.
+type crossing struct {
+	point geometry.Point
+	// ring and segment index of both segments and the position of the point along them, from 0 to 1
+	ringA int
+	segA  int
+	uA    float64
+	ringB int
+	segB  int
+	uB    float64
+}
+
+// Kinks takes a LineString, MultiLineString, Polygon or MultiPolygon, Geometry or Feature and returns its
+// self-intersection points as a FeatureCollection of Point Features.
+// The consecutive segments of a line or ring touching at their shared vertex are not kinks, unless the line turns back
+// along itself.
+// ref. http://turfjs.org/docs/#kinks
+func Kinks(t interface{}) (*feature.Collection, error) {
+	switch t.(type) {
+	case feature.Collection, *feature.Collection:
+		return nil, errors.New("a Feature or Geometry is required")
+	}
+	lines, err := rings(t)
+	if err != nil {
+		return nil, err
+	}
+
+	points := []geometry.Point{}
+	seen := map[geometry.Point]bool{}
+	for _, c := range selfCrossings(lines) {
+		if !seen[c.point] {
+			seen[c.point] = true
+			points = append(points, c.point)
+		}
+	}
+	return pointCollection(points)
+}
+
+// UnkinkPolygon takes a Polygon or MultiPolygon, Geometry, Feature or FeatureCollection and splits its
+// self-intersecting exterior rings into simple polygons, returned as a FeatureCollection of Polygon Features with
+// the properties of their feature.
+// Every ring is cut at its self-intersections and the loops are taken out one by one as they close. The holes are
+// assigned to the polygon containing them, it returns an error for a hole outside of all of them.
+// ref. http://turfjs.org/docs/#unkinkPolygon
+func UnkinkPolygon(t interface{}) (*feature.Collection, error) {
+	polys, err := polygonsOf(t)
+	if err != nil {
+		return nil, err
+	}
+
+	features := []feature.Feature{}
+	for _, p := range polys {
+		if len(p.polygon.Coordinates) == 0 {
+			continue
+		}
+		loops := splitLoops(p.polygon.Coordinates[0].Coordinates)
+		polygons := [][][]geometry.Point{}
+		for _, l := range loops {
+			polygons = append(polygons, [][]geometry.Point{l})
+		}
+		for _, hole := range p.polygon.Coordinates[1:] {
+			if len(hole.Coordinates) == 0 {
+				continue
+			}
+			inside := false
+			for i := range polygons {
+				if pointInRing(hole.Coordinates[0], polygons[i][0]) {
+					polygons[i] = append(polygons[i], hole.Coordinates)
+					inside = true
+					break
+				}
+			}
+			if !inside {
+				return nil, errors.New("a hole is outside of the polygons of its exterior ring")
+			}
+		}
+
+		for _, rs := range polygons {
+			coords := [][][]float64{}
+			for _, r := range rs {
+				coords = append(coords, pointsToCoords(r))
+			}
+			g := geometry.Geometry{
+				GeoJSONType: geojson.Polygon,
+				Coordinates: coords,
+			}
+			f, err := feature.New(g, bbox(rs[0]), copyMap(p.properties), "")
+			if err != nil {
+				return nil, err
+			}
+			features = append(features, *f)
+		}
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// selfCrossings returns the intersections between the segments of the lines, except the vertices shared by
+// consecutive segments of a line or a closed ring.
+func selfCrossings(lines [][]geometry.Point) []crossing {
+	type ref struct {
+		ring int
+		seg  int
+	}
+	segments := []segment{}
+	refs := []ref{}
+	for r, l := range lines {
+		for i := 1; i < len(l); i++ {
+			segments = append(segments, segment{a: l[i-1], b: l[i]})
+			refs = append(refs, ref{ring: r, seg: i - 1})
+		}
+	}
+
+	crossings := []crossing{}
+	sweep(boxesOf(segments), nil, func(i int, j int) {
+		ri := refs[i]
+		rj := refs[j]
+		if ri.ring == rj.ring {
+			n := len(lines[ri.ring]) - 1
+			closed := lines[ri.ring][0] == lines[ri.ring][n]
+			d := rj.seg - ri.seg
+			if d < 0 {
+				d = -d
+			}
+			if d == 1 || (closed && d == n-1) {
+				// consecutive segments only share their vertex, unless the second one turns back along the first one
+				if (rj.seg-ri.seg+n)%n != 1 {
+					i, j = j, i
+					ri, rj = rj, ri
+				}
+				uA, uB, ok := backtrack(segments[i], segments[j])
+				if ok {
+					crossings = append(crossings, crossing{
+						point: pointAt(segments[i], uA, segments[j], uB),
+						ringA: ri.ring, segA: ri.seg, uA: uA,
+						ringB: rj.ring, segB: rj.seg, uB: uB,
+					})
+				}
+				return
+			}
+		}
+		uA, uB, ok := segmentParams(segments[i], segments[j])
+		if !ok {
+			return
+		}
+		crossings = append(crossings, crossing{
+			point: pointAt(segments[i], uA, segments[j], uB),
+			ringA: ri.ring, segA: ri.seg, uA: uA,
+			ringB: rj.ring, segB: rj.seg, uB: uB,
+		})
+	})
+	return crossings
+}
+
+// backtrack returns the position along each segment of the end of their overlap when s2 starts at the end of s1 and
+// turns back along it.
+func backtrack(s1 segment, s2 segment) (float64, float64, bool) {
+	dx1, dy1 := s1.b.Lng-s1.a.Lng, s1.b.Lat-s1.a.Lat
+	dx2, dy2 := s2.b.Lng-s2.a.Lng, s2.b.Lat-s2.a.Lat
+	if dx1*dy2-dy1*dx2 != 0 || dx1*dx2+dy1*dy2 >= 0 {
+		return 0.0, 0.0, false
+	}
+	l1 := math.Hypot(dx1, dy1)
+	l2 := math.Hypot(dx2, dy2)
+	if l2 < l1 {
+		// s2 ends inside s1
+		return 1 - l2/l1, 1, true
+	}
+	// s2 passes over the start of s1
+	return 0, l1 / l2, true
+}
+
+// segmentParams returns the position of the intersection of two segments along each of them, from 0 to 1.
+func segmentParams(s1 segment, s2 segment) (float64, float64, bool) {
+	x1, y1 := s1.a.Lng, s1.a.Lat
+	x2, y2 := s1.b.Lng, s1.b.Lat
+	x3, y3 := s2.a.Lng, s2.a.Lat
+	x4, y4 := s2.b.Lng, s2.b.Lat
+
+	denom := (y4-y3)*(x2-x1) - (x4-x3)*(y2-y1)
+	if denom == 0 {
+		return 0.0, 0.0, false
+	}
+	uA := ((x4-x3)*(y1-y3) - (y4-y3)*(x1-x3)) / denom
+	uB := ((x2-x1)*(y1-y3) - (y2-y1)*(x1-x3)) / denom
+	if uA < 0 || uA > 1 || uB < 0 || uB > 1 {
+		return 0.0, 0.0, false
+	}
+	return uA, uB, true
+}
+
+// pointAt returns the intersection point, a vertex of one of the segments when the intersection is on it so
+// that the same point is found from every segment touching it.
+func pointAt(s1 segment, uA float64, s2 segment, uB float64) geometry.Point {
+	switch {
+	case uA == 0:
+		return s1.a
+	case uA == 1:
+		return s1.b
+	case uB == 0:
+		return s2.a
+	case uB == 1:
+		return s2.b
+	}
+	return geometry.Point{Lng: s1.a.Lng + uA*(s1.b.Lng-s1.a.Lng), Lat: s1.a.Lat + uA*(s1.b.Lat-s1.a.Lat)}
+}
+
+// splitLoops cuts a closed ring at its self-intersections and returns its simple loops as closed rings.
+func splitLoops(ring []geometry.Point) [][]geometry.Point {
+	if len(ring) < 4 {
+		return nil
+	}
+
+	// insert the crossings in the segments, ordered along them
+	type cut struct {
+		u float64
+		p geometry.Point
+	}
+	cuts := make([][]cut, len(ring)-1)
+	for _, c := range selfCrossings([][]geometry.Point{ring}) {
+		cuts[c.segA] = append(cuts[c.segA], cut{u: c.uA, p: c.point})
+		cuts[c.segB] = append(cuts[c.segB], cut{u: c.uB, p: c.point})
+	}
+	noded := []geometry.Point{}
+	for i := 0; i < len(ring)-1; i++ {
+		noded = append(noded, ring[i])
+		sort.Slice(cuts[i], func(a, b int) bool { return cuts[i][a].u < cuts[i][b].u })
+		for _, c := range cuts[i] {
+			if c.p != noded[len(noded)-1] && c.p != ring[i+1] {
+				noded = append(noded, c.p)
+			}
+		}
+	}
+	noded = append(noded, ring[0])
+
+	// walk the ring, a vertex already on the path closes a loop
+	loops := [][]geometry.Point{}
+	path := []geometry.Point{}
+	position := map[geometry.Point]int{}
+	for _, v := range noded {
+		k, ok := position[v]
+		if !ok {
+			position[v] = len(path)
+			path = append(path, v)
+			continue
+		}
+		loop := append([]geometry.Point{}, path[k:]...)
+		loop = append(loop, v)
+		if len(loop) >= 4 {
+			loops = append(loops, loop)
+		}
+		for _, p := range path[k+1:] {
+			delete(position, p)
+		}
+		path = path[:k+1]
+	}
+	return loops
+}
+
+// pointInRing reports whether the point is inside the ring with the ray casting test.
+func pointInRing(p geometry.Point, ring []geometry.Point) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a := ring[i]
+		b := ring[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) && p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// polygonWithProperties is a polygon and the properties of its feature.
+type polygonWithProperties struct {
+	polygon    geometry.Polygon
+	properties map[string]interface{}
+}
+
+// polygonsOf returns the polygons of a Polygon or MultiPolygon, Geometry, Feature or FeatureCollection.
+func polygonsOf(t interface{}) ([]polygonWithProperties, error) {
+	switch gtp := t.(type) {
+	case geometry.Polygon:
+		return []polygonWithProperties{{polygon: gtp}}, nil
+	case *geometry.Polygon:
+		return []polygonWithProperties{{polygon: *gtp}}, nil
+	case geometry.MultiPolygon:
+		return multiPolygons(gtp, nil), nil
+	case *geometry.MultiPolygon:
+		return multiPolygons(*gtp, nil), nil
+	case geometry.Geometry:
+		return geometryPolygons(&gtp, nil)
+	case *geometry.Geometry:
+		return geometryPolygons(gtp, nil)
+	case feature.Feature:
+		return geometryPolygons(&gtp.Geometry, gtp.Properties)
+	case *feature.Feature:
+		return geometryPolygons(&gtp.Geometry, gtp.Properties)
+	case feature.Collection:
+		return collectionPolygons(gtp.Features)
+	case *feature.Collection:
+		return collectionPolygons(gtp.Features)
+	}
+	return nil, errors.New("unknown polygon type")
+}
+
+func geometryPolygons(g *geometry.Geometry, props map[string]interface{}) ([]polygonWithProperties, error) {
+	switch g.GeoJSONType {
+	case geojson.Polygon:
+		p, err := g.ToPolygon()
+		if err != nil {
+			return nil, err
+		}
+		return []polygonWithProperties{{polygon: *p, properties: props}}, nil
+	case geojson.MultiPolygon:
+		mp, err := g.ToMultiPolygon()
+		if err != nil {
+			return nil, err
+		}
+		return multiPolygons(*mp, props), nil
+	}
+	return nil, errors.New("geometry must be a Polygon or a MultiPolygon")
+}
+
+func multiPolygons(mp geometry.MultiPolygon, props map[string]interface{}) []polygonWithProperties {
+	polys := []polygonWithProperties{}
+	for _, p := range mp.Coordinates {
+		polys = append(polys, polygonWithProperties{polygon: p, properties: props})
+	}
+	return polys
+}
+
+func collectionPolygons(features []feature.Feature) ([]polygonWithProperties, error) {
+	polys := []polygonWithProperties{}
+	for i := range features {
+		p, err := geometryPolygons(&features[i].Geometry, features[i].Properties)
+		if err != nil {
+			return nil, err
+		}
+		polys = append(polys, p...)
+	}
+	return polys, nil
+}
@@ -0,0 +1,126 @@
+package misc
+
+import (
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestKinks(t *testing.T) {
+	bowtie := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 2, Lat: 0}, {Lng: 0, Lat: 2}, {Lng: 0, Lat: 0},
+	}}}}
+	fc, err := Kinks(bowtie)
+	if err != nil {
+		t.Errorf("Kinks error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+	assert.Equal(t, fc.Features[0].Geometry.Coordinates, []float64{1, 1})
+
+	square := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: 2}, {Lng: 0, Lat: 0},
+	}}}}
+	fc, err = Kinks(square)
+	if err != nil {
+		t.Errorf("Kinks error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 0)
+
+	f, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: [][]float64{{0, 0}, {2, 0}, {2, 2}, {1, -1}},
+	}, nil, nil, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+	fc, err = Kinks(f)
+	if err != nil {
+		t.Errorf("Kinks error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+
+	// the line turns back along its first segment
+	backtracking := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 1, Lat: 0}}}
+	fc, err = Kinks(backtracking)
+	if err != nil {
+		t.Errorf("Kinks error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+	assert.Equal(t, fc.Features[0].Geometry.Coordinates, []float64{1, 0})
+}
+
+func TestUnkinkPolygon(t *testing.T) {
+	f, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.Polygon,
+		Coordinates: [][][]float64{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}},
+	}, nil, map[string]interface{}{"name": "bowtie"}, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+
+	fc, err := UnkinkPolygon(f)
+	if err != nil {
+		t.Errorf("UnkinkPolygon error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 2)
+	for _, p := range fc.Features {
+		assert.Equal(t, p.Properties["name"], "bowtie")
+		assert.Equal(t, len(p.Geometry.Coordinates.([][][]float64)[0]), 4)
+		k, err := Kinks(p)
+		if err != nil {
+			t.Errorf("Kinks error %v", err)
+			return
+		}
+		assert.Equal(t, len(k.Features), 0)
+	}
+
+	// a simple polygon keeps its hole
+	square := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 4, Lat: 0}, {Lng: 4, Lat: 4}, {Lng: 0, Lat: 4}, {Lng: 0, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 1, Lat: 1}, {Lng: 2, Lat: 1}, {Lng: 2, Lat: 2}, {Lng: 1, Lat: 1}}},
+	}}
+	fc, err = UnkinkPolygon(square)
+	if err != nil {
+		t.Errorf("UnkinkPolygon error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+	assert.Equal(t, len(fc.Features[0].Geometry.Coordinates.([][][]float64)), 2)
+
+	// a ring crossing itself twice
+	double := geometry.Polygon{Coordinates: []geometry.LineString{{Coordinates: []geometry.Point{
+		{Lng: 0, Lat: 0}, {Lng: 6, Lat: 0}, {Lng: 6, Lat: 2}, {Lng: 4, Lat: -1}, {Lng: 2, Lat: 2}, {Lng: 0, Lat: -1}, {Lng: 0, Lat: 0},
+	}}}}
+	fc, err = UnkinkPolygon(double)
+	if err != nil {
+		t.Errorf("UnkinkPolygon error %v", err)
+		return
+	}
+	for _, p := range fc.Features {
+		k, err := Kinks(p)
+		if err != nil {
+			t.Errorf("Kinks error %v", err)
+			return
+		}
+		assert.Equal(t, len(k.Features), 0)
+	}
+	assert.True(t, len(fc.Features) > 1)
+
+	// the hole is in none of the loops
+	outside := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 2}, {Lng: 2, Lat: 0}, {Lng: 0, Lat: 2}, {Lng: 0, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 0.9, Lat: 0.2}, {Lng: 1.1, Lat: 0.2}, {Lng: 1, Lat: 0.4}, {Lng: 0.9, Lat: 0.2}}},
+	}}
+	_, err = UnkinkPolygon(outside)
+	assert.True(t, err != nil)
+}
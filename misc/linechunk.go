@@ -0,0 +1,92 @@
+package misc
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// LineChunk divides the LineStrings of a LineString, MultiLineString, Feature or FeatureCollection into chunks of the
+// given length and returns them as a FeatureCollection of LineString Features with the properties of their feature.
+// The last chunk of every line is shorter when the line length is not a multiple of the segment length.
+// With reverse the lines are chunked from their end, the chunks keep the reversed vertex order.
+// ref. http://turfjs.org/docs/#lineChunk
+func LineChunk(t interface{}, segmentLength float64, units string, reverse bool) (*feature.Collection, error) {
+	if segmentLength <= 0 {
+		return nil, errors.New("segment length must be greater than 0")
+	}
+
+	features := []feature.Feature{}
+	switch gtp := t.(type) {
+	case feature.Collection:
+		for i := range gtp.Features {
+			chunks, err := chunkFeature(&gtp.Features[i], segmentLength, units, reverse)
+			if err != nil {
+				return nil, err
+			}
+			features = append(features, chunks...)
+		}
+	case *feature.Collection:
+		for i := range gtp.Features {
+			chunks, err := chunkFeature(&gtp.Features[i], segmentLength, units, reverse)
+			if err != nil {
+				return nil, err
+			}
+			features = append(features, chunks...)
+		}
+	default:
+		chunks, err := chunkFeature(t, segmentLength, units, reverse)
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, chunks...)
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+func chunkFeature(t interface{}, segmentLength float64, units string, reverse bool) ([]feature.Feature, error) {
+	lines, err := lineStrings(t)
+	if err != nil {
+		return nil, err
+	}
+	props := properties(t)
+
+	chunks := []feature.Feature{}
+	for _, l := range lines {
+		coords := make([]geometry.Point, len(l.Coordinates))
+		copy(coords, l.Coordinates)
+		if reverse {
+			for i, j := 0, len(coords)-1; i < j; i, j = i+1, j-1 {
+				coords[i], coords[j] = coords[j], coords[i]
+			}
+		}
+		ln := geometry.LineString{Coordinates: coords}
+
+		length, err := measurement.Length(ln, units)
+		if err != nil {
+			return nil, err
+		}
+		// tolerate the rounding of a length multiple of the segment length
+		n := int(math.Ceil(length/segmentLength - 1e-9))
+		if n <= 1 {
+			f, err := lineFeature(coords, copyMap(props))
+			if err != nil {
+				return nil, err
+			}
+			chunks = append(chunks, *f)
+			continue
+		}
+		for i := 0; i < n; i++ {
+			f, err := measurement.LineSliceAlong(ln, segmentLength*float64(i), segmentLength*float64(i+1), units)
+			if err != nil {
+				return nil, err
+			}
+			f.Properties = copyMap(props)
+			chunks = append(chunks, *f)
+		}
+	}
+	return chunks, nil
+}
@@ -0,0 +1,100 @@
+package misc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestLineChunk(t *testing.T) {
+	f, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: [][]float64{{0, 0}, {0.05, 0}, {0.05, 0.02}},
+	}, nil, map[string]interface{}{"road": "A1"}, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+	length, err := measurement.Length(geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0.05, Lat: 0}, {Lng: 0.05, Lat: 0.02}}}, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("Length error %v", err)
+		return
+	}
+
+	fc, err := LineChunk(f, 1, constants.UnitKilometers, false)
+	if err != nil {
+		t.Errorf("LineChunk error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), int(math.Ceil(length)))
+
+	total := 0.0
+	for i, c := range fc.Features {
+		assert.Equal(t, c.Properties["road"], "A1")
+		ln, err := c.ToLineString()
+		if err != nil {
+			t.Errorf("ToLineString error %v", err)
+			return
+		}
+		l, err := measurement.Length(*ln, constants.UnitKilometers)
+		if err != nil {
+			t.Errorf("Length error %v", err)
+			return
+		}
+		if i < len(fc.Features)-1 {
+			assert.True(t, math.Abs(l-1) < 1e-6)
+		}
+		total += l
+	}
+	assert.True(t, math.Abs(total-length) < 1e-6)
+	first := fc.Features[0].Geometry.Coordinates.([][]float64)
+	assert.Equal(t, first[0], []float64{0, 0})
+
+	// reversed chunks start at the end of the line
+	fc, err = LineChunk(f, 1, constants.UnitKilometers, true)
+	if err != nil {
+		t.Errorf("LineChunk error %v", err)
+		return
+	}
+	first = fc.Features[0].Geometry.Coordinates.([][]float64)
+	assert.Equal(t, first[0], []float64{0.05, 0.02})
+
+	// a line shorter than the segment length is returned whole
+	fc, err = LineChunk(f, 100, constants.UnitKilometers, false)
+	if err != nil {
+		t.Errorf("LineChunk error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+
+	ml := geometry.MultiLineString{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 0.02, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 1, Lat: 0}, {Lng: 1.02, Lat: 0}}},
+	}}
+	collection, err := feature.NewFeatureCollection([]feature.Feature{*f})
+	if err != nil {
+		t.Errorf("NewFeatureCollection error %v", err)
+		return
+	}
+	fc, err = LineChunk(ml, 1, constants.UnitKilometers, false)
+	if err != nil {
+		t.Errorf("LineChunk error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 6)
+	fc, err = LineChunk(collection, 1, constants.UnitKilometers, false)
+	if err != nil {
+		t.Errorf("LineChunk error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), int(math.Ceil(length)))
+
+	_, err = LineChunk(f, 0, constants.UnitKilometers, false)
+	assert.True(t, err != nil)
+}
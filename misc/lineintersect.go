@@ -0,0 +1,157 @@
+package misc
+
+import (
+	"math"
+	"sort"
+
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// segment is a segment of a line or of a polygon ring.
+type segment struct {
+	a geometry.Point
+	b geometry.Point
+}
+
+// box is the bounding box of a segment.
+type box struct {
+	minX float64
+	minY float64
+	maxX float64
+	maxY float64
+}
+
+func (s segment) box() box {
+	return box{
+		minX: math.Min(s.a.Lng, s.b.Lng),
+		minY: math.Min(s.a.Lat, s.b.Lat),
+		maxX: math.Max(s.a.Lng, s.b.Lng),
+		maxY: math.Max(s.a.Lat, s.b.Lat),
+	}
+}
+
+func boxesOf(segments []segment) []box {
+	boxes := []box{}
+	for _, s := range segments {
+		boxes = append(boxes, s.box())
+	}
+	return boxes
+}
+
+// LineIntersect takes two LineStrings, MultiLineStrings, Polygons or MultiPolygons, as Geometries, Features or
+// FeatureCollections, and returns the points where their lines or polygon boundaries cross as a FeatureCollection of
+// Point Features.
+// The segments are compared with a sweep along the longitude, only the segments whose bounding boxes overlap are
+// tested. The intersections are planar, collinear overlapping segments have no intersection point.
+// ref. http://turfjs.org/docs/#lineIntersect
+func LineIntersect(a interface{}, b interface{}) (*feature.Collection, error) {
+	ra, err := rings(a)
+	if err != nil {
+		return nil, err
+	}
+	rb, err := rings(b)
+	if err != nil {
+		return nil, err
+	}
+	sa := segmentsOf(ra)
+	sb := segmentsOf(rb)
+
+	points := []geometry.Point{}
+	seen := map[geometry.Point]bool{}
+	sweep(boxesOf(sa), boxesOf(sb), func(i int, j int) {
+		if p, ok := intersectSegments(sa[i], sb[j]); ok && !seen[p] {
+			seen[p] = true
+			points = append(points, p)
+		}
+	})
+	return pointCollection(points)
+}
+
+func segmentsOf(lines [][]geometry.Point) []segment {
+	segments := []segment{}
+	for _, l := range lines {
+		for i := 1; i < len(l); i++ {
+			segments = append(segments, segment{a: l[i-1], b: l[i]})
+		}
+	}
+	return segments
+}
+
+// sweep calls fn for every pair of boxes of a and b that overlap. When b is nil the pairs of different boxes of a
+// are visited, each one once with i < j.
+// The boxes are visited by increasing minimum longitude, a box stays active until the sweep passes its maximum
+// longitude.
+func sweep(a []box, b []box, fn func(i int, j int)) {
+	self := b == nil
+	type event struct {
+		set   int
+		index int
+	}
+	sets := [][]box{a, b}
+	events := []event{}
+	for set, boxes := range sets {
+		for i := range boxes {
+			events = append(events, event{set: set, index: i})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return sets[events[i].set][events[i].index].minX < sets[events[j].set][events[j].index].minX
+	})
+
+	active := [][]int{{}, {}}
+	for _, e := range events {
+		other := 1 - e.set
+		if self {
+			other = e.set
+		}
+		current := sets[e.set][e.index]
+		// drop the boxes the sweep has passed
+		kept := active[other][:0]
+		for _, k := range active[other] {
+			if sets[other][k].maxX >= current.minX {
+				kept = append(kept, k)
+			}
+		}
+		active[other] = kept
+
+		for _, k := range active[other] {
+			o := sets[other][k]
+			if current.minY > o.maxY || o.minY > current.maxY {
+				continue
+			}
+			switch {
+			case self && k < e.index:
+				fn(k, e.index)
+			case self:
+				fn(e.index, k)
+			case e.set == 0:
+				fn(e.index, k)
+			default:
+				fn(k, e.index)
+			}
+		}
+		active[e.set] = append(active[e.set], e.index)
+	}
+}
+
+// intersectSegments returns the planar intersection point of two segments.
+func intersectSegments(s1 segment, s2 segment) (geometry.Point, bool) {
+	uA, uB, ok := segmentParams(s1, s2)
+	if !ok {
+		return geometry.Point{}, false
+	}
+	return pointAt(s1, uA, s2, uB), true
+}
+
+func pointCollection(points []geometry.Point) (*feature.Collection, error) {
+	features := []feature.Feature{}
+	for _, p := range points {
+		f, err := pointFeature(p, map[string]interface{}{})
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, *f)
+	}
+	return feature.NewFeatureCollection(features)
+}
@@ -0,0 +1,88 @@
+package misc
+
+import (
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestLineIntersect(t *testing.T) {
+	a := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 2}}}
+	b := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 2}, {Lng: 2, Lat: 0}}}
+
+	fc, err := LineIntersect(a, b)
+	if err != nil {
+		t.Errorf("LineIntersect error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+	assert.Equal(t, fc.Features[0].Geometry.GeoJSONType, geojson.Point)
+	assert.Equal(t, fc.Features[0].Geometry.Coordinates, []float64{1, 1})
+
+	// a line crossing the boundary of a polygon twice
+	poly, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.Polygon,
+		Coordinates: [][][]float64{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}},
+	}, nil, nil, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+	fc, err = LineIntersect(geometry.LineString{Coordinates: []geometry.Point{{Lng: -1, Lat: 2}, {Lng: 5, Lat: 2}}}, poly)
+	if err != nil {
+		t.Errorf("LineIntersect error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 2)
+
+	// the shared vertex of two segments is reported once
+	fc, err = LineIntersect(geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 2}, {Lng: 2, Lat: 2}, {Lng: 4, Lat: 2}}},
+		geometry.LineString{Coordinates: []geometry.Point{{Lng: 2, Lat: 0}, {Lng: 2, Lat: 4}}})
+	if err != nil {
+		t.Errorf("LineIntersect error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+
+	_, err = LineIntersect(geometry.Point{}, b)
+	assert.True(t, err != nil)
+}
+
+func TestLineIntersectSweep(t *testing.T) {
+	// zigzags crossing a grid of lines, the sweep must find the same crossings as the pairwise comparison
+	zigzag := []geometry.LineString{}
+	for i := 0; i < 20; i++ {
+		coords := []geometry.Point{}
+		for j := 0; j <= 40; j++ {
+			coords = append(coords, geometry.Point{Lng: float64(j) * 0.5, Lat: float64(i) + 0.3*float64(j%2)})
+		}
+		zigzag = append(zigzag, geometry.LineString{Coordinates: coords})
+	}
+	grid := []geometry.LineString{}
+	for i := 0; i < 25; i++ {
+		x := 0.37 + float64(i)*0.8
+		grid = append(grid, geometry.LineString{Coordinates: []geometry.Point{{Lng: x, Lat: -1}, {Lng: x + 0.1, Lat: 21}}})
+	}
+	a := geometry.MultiLineString{Coordinates: zigzag}
+	b := geometry.MultiLineString{Coordinates: grid}
+
+	fc, err := LineIntersect(a, b)
+	if err != nil {
+		t.Errorf("LineIntersect error %v", err)
+		return
+	}
+
+	expected := map[geometry.Point]bool{}
+	for _, s1 := range segmentsOf(lineStringCoords(zigzag)) {
+		for _, s2 := range segmentsOf(lineStringCoords(grid)) {
+			if p, ok := intersectSegments(s1, s2); ok {
+				expected[p] = true
+			}
+		}
+	}
+	assert.Equal(t, len(fc.Features), len(expected))
+	assert.Equal(t, len(fc.Features), 20*25)
+}
@@ -0,0 +1,217 @@
+package misc
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// piece is a LineString of a feature to merge, with the properties of its feature.
+type piece struct {
+	points     []geometry.Point
+	properties map[string]interface{}
+}
+
+// LineMerge takes a FeatureCollection of LineString or MultiLineString Features and sews the lines sharing endpoints
+// into the longest possible continuous lines. The lines are joined where exactly two of them meet, a junction of three
+// or more lines ends them.
+// Every connected group of lines is returned as a LineString Feature, or as a MultiLineString Feature when it does not
+// merge into a single line, with the properties shared by all its lines.
+// With a property name only the lines of the features with equal values of the property are merged.
+// ref. http://turfjs.org/docs/#lineMerge
+func LineMerge(fc interface{}, property string) (*feature.Collection, error) {
+	var features []feature.Feature
+	switch gtp := fc.(type) {
+	case feature.Collection:
+		features = gtp.Features
+	case *feature.Collection:
+		features = gtp.Features
+	default:
+		return nil, errors.New("a FeatureCollection is required")
+	}
+
+	groups := [][]piece{}
+	groupIndex := map[string]int{}
+	for i := range features {
+		lines, err := lineStrings(&features[i])
+		if err != nil {
+			return nil, err
+		}
+		key := ""
+		if property != "" {
+			key = fmt.Sprintf("%#v", features[i].Properties[property])
+		}
+		g, ok := groupIndex[key]
+		if !ok {
+			g = len(groups)
+			groupIndex[key] = g
+			groups = append(groups, []piece{})
+		}
+		for _, l := range lines {
+			if len(l.Coordinates) < 2 {
+				continue
+			}
+			groups[g] = append(groups[g], piece{points: l.Coordinates, properties: features[i].Properties})
+		}
+	}
+
+	merged := []feature.Feature{}
+	for _, pieces := range groups {
+		for _, component := range components(pieces) {
+			f, err := mergeComponent(pieces, component)
+			if err != nil {
+				return nil, err
+			}
+			merged = append(merged, *f)
+		}
+	}
+	return feature.NewFeatureCollection(merged)
+}
+
+// components returns the indices of the pieces connected by their endpoints, in the order of their first piece.
+func components(pieces []piece) [][]int {
+	parent := make([]int, len(pieces))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	owner := map[geometry.Point]int{}
+	for i, p := range pieces {
+		for _, end := range []geometry.Point{p.points[0], p.points[len(p.points)-1]} {
+			if j, ok := owner[end]; ok {
+				a, b := find(i), find(j)
+				if a < b {
+					parent[b] = a
+				} else {
+					parent[a] = b
+				}
+				continue
+			}
+			owner[end] = i
+		}
+	}
+
+	result := [][]int{}
+	index := map[int]int{}
+	for i := range pieces {
+		root := find(i)
+		c, ok := index[root]
+		if !ok {
+			c = len(result)
+			index[root] = c
+			result = append(result, []int{})
+		}
+		result[c] = append(result[c], i)
+	}
+	return result
+}
+
+// mergeComponent joins the pieces of a connected component at the endpoints shared by exactly two of them.
+func mergeComponent(pieces []piece, component []int) (*feature.Feature, error) {
+	// the pieces ending at every endpoint, a closed piece is counted twice at its endpoint
+	ends := map[geometry.Point][]int{}
+	for _, i := range component {
+		p := pieces[i].points
+		ends[p[0]] = append(ends[p[0]], i)
+		ends[p[len(p)-1]] = append(ends[p[len(p)-1]], i)
+	}
+
+	visited := map[int]bool{}
+	walk := func(start int, from geometry.Point) []geometry.Point {
+		line := []geometry.Point{}
+		i, node := start, from
+		for {
+			visited[i] = true
+			points := orient(pieces[i].points, node)
+			if len(line) == 0 {
+				line = append(line, points...)
+			} else {
+				line = append(line, points[1:]...)
+			}
+			node = points[len(points)-1]
+			if len(ends[node]) != 2 {
+				return line
+			}
+			next := ends[node][0]
+			if next == i {
+				next = ends[node][1]
+			}
+			if visited[next] {
+				return line
+			}
+			i = next
+		}
+	}
+
+	lines := [][]geometry.Point{}
+	// the lines start at the free ends and the junctions
+	for _, i := range component {
+		if visited[i] {
+			continue
+		}
+		p := pieces[i].points
+		if len(ends[p[0]]) != 2 {
+			lines = append(lines, walk(i, p[0]))
+		} else if len(ends[p[len(p)-1]]) != 2 {
+			lines = append(lines, walk(i, p[len(p)-1]))
+		}
+	}
+	// the remaining pieces form rings
+	for _, i := range component {
+		if !visited[i] {
+			lines = append(lines, walk(i, pieces[i].points[0]))
+		}
+	}
+
+	props := sharedProperties(pieces, component)
+	if len(lines) == 1 {
+		return lineFeature(lines[0], props)
+	}
+	coords := [][][]float64{}
+	all := []geometry.Point{}
+	for _, l := range lines {
+		coords = append(coords, pointsToCoords(l))
+		all = append(all, l...)
+	}
+	g := geometry.Geometry{
+		GeoJSONType: geojson.MultiLineString,
+		Coordinates: coords,
+	}
+	return feature.New(g, bbox(all), props, "")
+}
+
+// orient returns the points of a piece starting from the endpoint from.
+func orient(points []geometry.Point, from geometry.Point) []geometry.Point {
+	if points[0] == from {
+		return points
+	}
+	reversed := make([]geometry.Point, len(points))
+	for i, p := range points {
+		reversed[len(points)-1-i] = p
+	}
+	return reversed
+}
+
+// sharedProperties returns the properties with the same value in all the pieces of a component.
+func sharedProperties(pieces []piece, component []int) map[string]interface{} {
+	props := copyMap(pieces[component[0]].properties)
+	for _, i := range component[1:] {
+		for k, v := range props {
+			if w, ok := pieces[i].properties[k]; !ok || !reflect.DeepEqual(v, w) {
+				delete(props, k)
+			}
+		}
+	}
+	return props
+}
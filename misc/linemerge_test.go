@@ -0,0 +1,85 @@
+package misc
+
+import (
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+)
+
+func TestLineMerge(t *testing.T) {
+	json := "{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"Main St\", \"way\": 1 }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [1, 0]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"Main St\", \"way\": 2 }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[2, 0], [1, 0]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"Main St\", \"way\": 3 }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[2, 0], [3, 0], [3, 1]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"Side St\", \"way\": 4 }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[10, 0], [11, 0]] } }]}"
+	fc, err := feature.CollectionFromJSON(json)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+		return
+	}
+
+	merged, err := LineMerge(fc, "")
+	if err != nil {
+		t.Errorf("LineMerge error %v", err)
+		return
+	}
+	assert.Equal(t, len(merged.Features), 2)
+	assert.Equal(t, merged.Features[0].Geometry.GeoJSONType, geojson.LineString)
+	assert.Equal(t, merged.Features[0].Geometry.Coordinates, [][]float64{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {3, 1}})
+	assert.Equal(t, merged.Features[0].Properties, map[string]interface{}{"name": "Main St"})
+	assert.Equal(t, merged.Features[1].Geometry.Coordinates, [][]float64{{10, 0}, {11, 0}})
+
+	// a junction of three lines ends them
+	json = "{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"class\": \"road\" }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [1, 0]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"class\": \"road\" }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[1, 0], [2, 0]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"class\": \"path\" }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[1, 0], [1, 1]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"class\": \"path\" }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[1, 1], [1, 2]] } }]}"
+	fc, err = feature.CollectionFromJSON(json)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+		return
+	}
+	merged, err = LineMerge(fc, "")
+	if err != nil {
+		t.Errorf("LineMerge error %v", err)
+		return
+	}
+	assert.Equal(t, len(merged.Features), 1)
+	assert.Equal(t, merged.Features[0].Geometry.GeoJSONType, geojson.MultiLineString)
+	assert.Equal(t, merged.Features[0].Geometry.Coordinates, [][][]float64{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}, {{1, 0}, {1, 1}, {1, 2}}})
+	assert.Equal(t, len(merged.Features[0].Properties), 0)
+
+	// the lines are merged by class only
+	merged, err = LineMerge(fc, "class")
+	if err != nil {
+		t.Errorf("LineMerge error %v", err)
+		return
+	}
+	assert.Equal(t, len(merged.Features), 2)
+	assert.Equal(t, merged.Features[0].Geometry.Coordinates, [][]float64{{0, 0}, {1, 0}, {2, 0}})
+	assert.Equal(t, merged.Features[0].Properties["class"], "road")
+	assert.Equal(t, merged.Features[1].Geometry.Coordinates, [][]float64{{1, 0}, {1, 1}, {1, 2}})
+
+	// a ring of lines
+	json = "{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [1, 0], [1, 1]] } }," +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [1, 1]] } }]}"
+	fc, err = feature.CollectionFromJSON(json)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+		return
+	}
+	merged, err = LineMerge(fc, "")
+	if err != nil {
+		t.Errorf("LineMerge error %v", err)
+		return
+	}
+	assert.Equal(t, len(merged.Features), 1)
+	assert.Equal(t, merged.Features[0].Geometry.Coordinates, [][]float64{{0, 0}, {1, 0}, {1, 1}, {0, 0}})
+
+	_, err = LineMerge(fc.Features[0], "")
+	assert.True(t, err != nil)
+}
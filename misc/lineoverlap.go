@@ -0,0 +1,190 @@
+package misc
+
+import (
+	"math"
+
+	"github.com/et-soft/turf-go/conversions"
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// LineOverlap takes two LineStrings, MultiLineStrings, Polygons or MultiPolygons, as Geometries, Features or
+// FeatureCollections, and returns the segments they share as a FeatureCollection of LineString Features.
+// A segment of one line overlaps the other one when both its ends are on a segment of the other line, or, for
+// partially overlapping segments, the part between the ends lying on each other is returned.
+// With a tolerance greater than 0 the points closer than the tolerance to a segment are on it, otherwise they must
+// be exactly on it. The consecutive overlapping segments are joined in a single LineString.
+// ref. http://turfjs.org/docs/#lineOverlap
+func LineOverlap(line1 interface{}, line2 interface{}, tolerance float64, units string) (*feature.Collection, error) {
+	r1, err := rings(line1)
+	if err != nil {
+		return nil, err
+	}
+	r2, err := rings(line2)
+	if err != nil {
+		return nil, err
+	}
+	s1 := segmentsOf(r1)
+	s2 := segmentsOf(r2)
+
+	// pad the boxes by the tolerance, the longitude degrees shrink with the latitude
+	padY := 0.0
+	padX := 0.0
+	if tolerance > 0 {
+		padY, err = conversions.LengthToDegrees(tolerance, units)
+		if err != nil {
+			return nil, err
+		}
+		maxLat := 0.0
+		for _, s := range append(append([]segment{}, s1...), s2...) {
+			maxLat = math.Max(maxLat, math.Max(math.Abs(s.a.Lat), math.Abs(s.b.Lat)))
+		}
+		padX = padY / math.Max(math.Cos(conversions.DegreesToRadians(math.Min(maxLat+padY, 90))), 1e-9)
+	}
+	pad := func(segments []segment) []box {
+		boxes := boxesOf(segments)
+		for i := range boxes {
+			boxes[i] = box{boxes[i].minX - padX, boxes[i].minY - padY, boxes[i].maxX + padX, boxes[i].maxY + padY}
+		}
+		return boxes
+	}
+
+	on := func(p geometry.Point, s segment) (bool, error) {
+		if tolerance <= 0 {
+			return onSegment(p, s), nil
+		}
+		n, _, _, err := nearestOnSegment(s.a, s.b, p, units)
+		if err != nil {
+			return false, err
+		}
+		d, err := measurement.PointDistance(p, n, units)
+		if err != nil {
+			return false, err
+		}
+		return d <= tolerance, nil
+	}
+
+	// the overlaps found for every segment of the second line, in its order
+	overlaps := make([][]segment, len(s2))
+	var failure error
+	sweep(pad(s1), pad(s2), func(i int, j int) {
+		if failure != nil {
+			return
+		}
+		o, ok, err := segmentOverlap(s1[i], s2[j], on)
+		if err != nil {
+			failure = err
+			return
+		}
+		if ok {
+			overlaps[j] = append(overlaps[j], o)
+		}
+	})
+	if failure != nil {
+		return nil, failure
+	}
+
+	features := []feature.Feature{}
+	var current []geometry.Point
+	flush := func() error {
+		if len(current) > 1 {
+			f, err := lineFeature(current, map[string]interface{}{})
+			if err != nil {
+				return err
+			}
+			features = append(features, *f)
+		}
+		current = nil
+		return nil
+	}
+	seen := map[segment]bool{}
+	for _, segments := range overlaps {
+		for _, o := range segments {
+			if seen[o] || seen[segment{a: o.b, b: o.a}] {
+				continue
+			}
+			seen[o] = true
+			if len(current) > 0 && current[len(current)-1] == o.a {
+				current = append(current, o.b)
+				continue
+			}
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			current = []geometry.Point{o.a, o.b}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return feature.NewFeatureCollection(features)
+}
+
+// segmentOverlap returns the part shared by the segments s1 and s2, oriented as s2.
+func segmentOverlap(s1 segment, s2 segment, on func(geometry.Point, segment) (bool, error)) (segment, bool, error) {
+	a2on1, err := on(s2.a, s1)
+	if err != nil {
+		return segment{}, false, err
+	}
+	b2on1, err := on(s2.b, s1)
+	if err != nil {
+		return segment{}, false, err
+	}
+	if a2on1 && b2on1 {
+		return s2, s2.a != s2.b, nil
+	}
+	a1on2, err := on(s1.a, s2)
+	if err != nil {
+		return segment{}, false, err
+	}
+	b1on2, err := on(s1.b, s2)
+	if err != nil {
+		return segment{}, false, err
+	}
+	switch {
+	case a1on2 && b1on2:
+		// s1 is inside s2, orient it as s2
+		if dot(s1.b.Lng-s1.a.Lng, s1.b.Lat-s1.a.Lat, s2.b.Lng-s2.a.Lng, s2.b.Lat-s2.a.Lat) < 0 {
+			return segment{a: s1.b, b: s1.a}, s1.a != s1.b, nil
+		}
+		return s1, s1.a != s1.b, nil
+	case a2on1 && (a1on2 || b1on2):
+		end := s1.a
+		if b1on2 {
+			end = s1.b
+		}
+		return partialOverlap(s2.a, end, on)
+	case b2on1 && (a1on2 || b1on2):
+		start := s1.a
+		if b1on2 {
+			start = s1.b
+		}
+		return partialOverlap(start, s2.b, on)
+	}
+	return segment{}, false, nil
+}
+
+// partialOverlap returns the segment from start to end, unless they are the same point within the tolerance and the
+// segments only touch.
+func partialOverlap(start geometry.Point, end geometry.Point, on func(geometry.Point, segment) (bool, error)) (segment, bool, error) {
+	touch, err := on(end, segment{a: start, b: start})
+	if err != nil {
+		return segment{}, false, err
+	}
+	return segment{a: start, b: end}, !touch, nil
+}
+
+// onSegment reports whether the point is exactly on the segment.
+func onSegment(p geometry.Point, s segment) bool {
+	cross := (p.Lng-s.a.Lng)*(s.b.Lat-s.a.Lat) - (p.Lat-s.a.Lat)*(s.b.Lng-s.a.Lng)
+	if cross != 0 {
+		return false
+	}
+	b := s.box()
+	return p.Lng >= b.minX && p.Lng <= b.maxX && p.Lat >= b.minY && p.Lat <= b.maxY
+}
+
+func dot(x1 float64, y1 float64, x2 float64, y2 float64) float64 {
+	return x1*x2 + y1*y2
+}
@@ -0,0 +1,77 @@
+package misc
+
+import (
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestLineOverlap(t *testing.T) {
+	route1 := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 3, Lat: 1}}}
+	route2 := geometry.LineString{Coordinates: []geometry.Point{{Lng: 1, Lat: -1}, {Lng: 1, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: -1}}}
+
+	fc, err := LineOverlap(route1, route2, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineOverlap error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+	assert.Equal(t, fc.Features[0].Geometry.GeoJSONType, geojson.LineString)
+	assert.Equal(t, fc.Features[0].Geometry.Coordinates, [][]float64{{1, 0}, {2, 0}})
+
+	// consecutive shared segments are joined
+	route3 := geometry.LineString{Coordinates: []geometry.Point{{Lng: -1, Lat: 1}, {Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 2, Lat: 0}, {Lng: 2, Lat: 1}}}
+	fc, err = LineOverlap(route1, route3, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineOverlap error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+	assert.Equal(t, fc.Features[0].Geometry.Coordinates, [][]float64{{0, 0}, {1, 0}, {2, 0}})
+
+	// a partially overlapping segment
+	fc, err = LineOverlap(geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 2, Lat: 0}}},
+		geometry.LineString{Coordinates: []geometry.Point{{Lng: 1, Lat: 0}, {Lng: 3, Lat: 0}}}, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineOverlap error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+	assert.Equal(t, fc.Features[0].Geometry.Coordinates, [][]float64{{1, 0}, {2, 0}})
+
+	// nearly coincident lines with a tolerance
+	shifted := geometry.LineString{Coordinates: []geometry.Point{{Lng: 1, Lat: 0.00001}, {Lng: 2, Lat: 0.00001}}}
+	fc, err = LineOverlap(route1, shifted, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineOverlap error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 0)
+	fc, err = LineOverlap(route1, shifted, 0.005, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineOverlap error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+
+	// the boundary of a polygon
+	poly, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.Polygon,
+		Coordinates: [][][]float64{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}},
+	}, nil, nil, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+	fc, err = LineOverlap(poly, geometry.LineString{Coordinates: []geometry.Point{{Lng: 2, Lat: -1}, {Lng: 2, Lat: 3}}}, 0, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("LineOverlap error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 1)
+	assert.Equal(t, fc.Features[0].Geometry.Coordinates, [][]float64{{2, 0}, {2, 2}})
+}
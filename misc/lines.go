@@ -0,0 +1,237 @@
+package misc
+
+import (
+	"errors"
+
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// lineStrings returns the LineStrings of a LineString or MultiLineString, Geometry or Feature.
+func lineStrings(t interface{}) ([]geometry.LineString, error) {
+	switch gtp := t.(type) {
+	case geometry.LineString:
+		return []geometry.LineString{gtp}, nil
+	case *geometry.LineString:
+		return []geometry.LineString{*gtp}, nil
+	case geometry.MultiLineString:
+		return gtp.Coordinates, nil
+	case *geometry.MultiLineString:
+		return gtp.Coordinates, nil
+	case geometry.Geometry:
+		return geometryLineStrings(&gtp)
+	case *geometry.Geometry:
+		return geometryLineStrings(gtp)
+	case feature.Feature:
+		return geometryLineStrings(&gtp.Geometry)
+	case *feature.Feature:
+		return geometryLineStrings(&gtp.Geometry)
+	}
+	return nil, errors.New("unknown line type")
+}
+
+func geometryLineStrings(g *geometry.Geometry) ([]geometry.LineString, error) {
+	switch g.GeoJSONType {
+	case geojson.LineString:
+		ln, err := g.ToLineString()
+		if err != nil {
+			return nil, err
+		}
+		return []geometry.LineString{*ln}, nil
+	case geojson.MultiLineString:
+		ml, err := g.ToMultiLineString()
+		if err != nil {
+			return nil, err
+		}
+		return ml.Coordinates, nil
+	}
+	return nil, errors.New("geometry must be a LineString or a MultiLineString")
+}
+
+// lineType returns the geometry type of a line, LineString or MultiLineString.
+func lineType(t interface{}) geojson.OBjectType {
+	switch gtp := t.(type) {
+	case geometry.MultiLineString, *geometry.MultiLineString:
+		return geojson.MultiLineString
+	case geometry.Geometry:
+		return gtp.GeoJSONType
+	case *geometry.Geometry:
+		return gtp.GeoJSONType
+	case feature.Feature:
+		return gtp.Geometry.GeoJSONType
+	case *feature.Feature:
+		return gtp.Geometry.GeoJSONType
+	}
+	return geojson.LineString
+}
+
+// lineString returns the coordinates of a single LineString, Geometry or Feature.
+func lineString(t interface{}) ([]geometry.Point, error) {
+	lines, err := lineStrings(t)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) != 1 {
+		return nil, errors.New("a LineString is required")
+	}
+	if len(lines[0].Coordinates) < 2 {
+		return nil, errors.New("a LineString must have at least two positions")
+	}
+	return lines[0].Coordinates, nil
+}
+
+// properties returns a copy of the properties of a Feature, other objects have no properties.
+func properties(t interface{}) map[string]interface{} {
+	switch gtp := t.(type) {
+	case feature.Feature:
+		return copyMap(gtp.Properties)
+	case *feature.Feature:
+		return copyMap(gtp.Properties)
+	}
+	return map[string]interface{}{}
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	c := map[string]interface{}{}
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+func pointsToCoords(points []geometry.Point) [][]float64 {
+	coords := [][]float64{}
+	for _, p := range points {
+		coords = append(coords, []float64{p.Lng, p.Lat})
+	}
+	return coords
+}
+
+func bbox(points []geometry.Point) []float64 {
+	if len(points) == 0 {
+		return nil
+	}
+	b := []float64{points[0].Lng, points[0].Lat, points[0].Lng, points[0].Lat}
+	for _, p := range points[1:] {
+		if p.Lng < b[0] {
+			b[0] = p.Lng
+		}
+		if p.Lat < b[1] {
+			b[1] = p.Lat
+		}
+		if p.Lng > b[2] {
+			b[2] = p.Lng
+		}
+		if p.Lat > b[3] {
+			b[3] = p.Lat
+		}
+	}
+	return b
+}
+
+// lineFeature returns the points as a LineString Feature.
+func lineFeature(points []geometry.Point, props map[string]interface{}) (*feature.Feature, error) {
+	g := geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: pointsToCoords(points),
+	}
+	return feature.New(g, bbox(points), props, "")
+}
+
+// pointFeature returns the point as a Point Feature.
+func pointFeature(p geometry.Point, props map[string]interface{}) (*feature.Feature, error) {
+	g := geometry.Geometry{
+		GeoJSONType: geojson.Point,
+		Coordinates: []float64{p.Lng, p.Lat},
+	}
+	return feature.New(g, []float64{p.Lng, p.Lat, p.Lng, p.Lat}, props, "")
+}
+
+// rings returns the LineStrings and the polygon rings of a LineString, MultiLineString, Polygon or MultiPolygon,
+// Geometry, Feature or FeatureCollection.
+func rings(t interface{}) ([][]geometry.Point, error) {
+	switch gtp := t.(type) {
+	case geometry.LineString:
+		return [][]geometry.Point{gtp.Coordinates}, nil
+	case *geometry.LineString:
+		return [][]geometry.Point{gtp.Coordinates}, nil
+	case geometry.MultiLineString:
+		return lineStringCoords(gtp.Coordinates), nil
+	case *geometry.MultiLineString:
+		return lineStringCoords(gtp.Coordinates), nil
+	case geometry.Polygon:
+		return lineStringCoords(gtp.Coordinates), nil
+	case *geometry.Polygon:
+		return lineStringCoords(gtp.Coordinates), nil
+	case geometry.MultiPolygon:
+		return multiPolygonRings(gtp), nil
+	case *geometry.MultiPolygon:
+		return multiPolygonRings(*gtp), nil
+	case geometry.Geometry:
+		return geometryRings(&gtp)
+	case *geometry.Geometry:
+		return geometryRings(gtp)
+	case feature.Feature:
+		return geometryRings(&gtp.Geometry)
+	case *feature.Feature:
+		return geometryRings(&gtp.Geometry)
+	case feature.Collection:
+		return collectionRings(gtp.Features)
+	case *feature.Collection:
+		return collectionRings(gtp.Features)
+	}
+	return nil, errors.New("unknown geometry type")
+}
+
+func geometryRings(g *geometry.Geometry) ([][]geometry.Point, error) {
+	switch g.GeoJSONType {
+	case geojson.LineString, geojson.MultiLineString:
+		lines, err := geometryLineStrings(g)
+		if err != nil {
+			return nil, err
+		}
+		return lineStringCoords(lines), nil
+	case geojson.Polygon:
+		p, err := g.ToPolygon()
+		if err != nil {
+			return nil, err
+		}
+		return lineStringCoords(p.Coordinates), nil
+	case geojson.MultiPolygon:
+		mp, err := g.ToMultiPolygon()
+		if err != nil {
+			return nil, err
+		}
+		return multiPolygonRings(*mp), nil
+	}
+	return nil, errors.New("geometry must be a line or a polygon")
+}
+
+func collectionRings(features []feature.Feature) ([][]geometry.Point, error) {
+	all := [][]geometry.Point{}
+	for i := range features {
+		r, err := geometryRings(&features[i].Geometry)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, r...)
+	}
+	return all, nil
+}
+
+func lineStringCoords(lines []geometry.LineString) [][]geometry.Point {
+	coords := [][]geometry.Point{}
+	for _, l := range lines {
+		coords = append(coords, l.Coordinates)
+	}
+	return coords
+}
+
+func multiPolygonRings(mp geometry.MultiPolygon) [][]geometry.Point {
+	coords := [][]geometry.Point{}
+	for _, p := range mp.Coordinates {
+		coords = append(coords, lineStringCoords(p.Coordinates)...)
+	}
+	return coords
+}
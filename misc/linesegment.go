@@ -0,0 +1,22 @@
+package misc
+
+import (
+	meta "github.com/et-soft/turf-go/meta/segmentEach"
+	"github.com/tomchavakis/geojson/feature"
+)
+
+// LineSegment takes any line or polygon GeoJSON object and returns its 2-vertex segments as a FeatureCollection of
+// LineString Features with the properties of their feature.
+// geojson can be a FeatureCollection | Feature | Geometry | GeometryCollection, as pointers.
+// ref. http://turfjs.org/docs/#lineSegment
+func LineSegment(geojson interface{}) (*feature.Collection, error) {
+	features := []feature.Feature{}
+	err := meta.SegmentEach(geojson, func(segment feature.Feature, featureIndex int, multiFeatureIndex int, geometryIndex int, segmentIndex int) bool {
+		features = append(features, segment)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return feature.NewFeatureCollection(features)
+}
@@ -0,0 +1,48 @@
+package misc
+
+import (
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestLineSegment(t *testing.T) {
+	f, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.Polygon,
+		Coordinates: [][][]float64{{{0, 0}, {2, 0}, {2, 2}, {0, 0}}, {{0.5, 0.2}, {1, 0.2}, {1, 0.5}, {0.5, 0.2}}},
+	}, nil, map[string]interface{}{"name": "parcel"}, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+
+	fc, err := LineSegment(f)
+	if err != nil {
+		t.Errorf("LineSegment error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 6)
+	for _, s := range fc.Features {
+		assert.Equal(t, s.Geometry.GeoJSONType, geojson.LineString)
+		assert.Equal(t, len(s.Geometry.Coordinates.([][]float64)), 2)
+		assert.Equal(t, s.Properties["name"], "parcel")
+	}
+	assert.Equal(t, fc.Features[3].Geometry.Coordinates, [][]float64{{0.5, 0.2}, {1, 0.2}})
+
+	ml := geometry.MultiLineString{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 5, Lat: 0}, {Lng: 6, Lat: 0}, {Lng: 6, Lat: 1}}},
+	}}
+	fc, err = LineSegment(&ml)
+	if err != nil {
+		t.Errorf("LineSegment error %v", err)
+		return
+	}
+	assert.Equal(t, len(fc.Features), 3)
+
+	_, err = LineSegment("line")
+	assert.True(t, err != nil)
+}
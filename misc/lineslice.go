@@ -0,0 +1,42 @@
+package misc
+
+import (
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// LineSlice takes a LineString Geometry or Feature and two points and returns the section of the line between them as
+// a LineString Feature. The points don't need to be on the line, they are snapped to the closest point of it.
+// The section keeps the vertex order of the line whatever the order of the points.
+// ref. http://turfjs.org/docs/#lineSlice
+func LineSlice(startPt geometry.Point, stopPt geometry.Point, line interface{}) (*feature.Feature, error) {
+	coords, err := lineString(line)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := nearestOnLine(coords, startPt, constants.UnitDefault)
+	if err != nil {
+		return nil, err
+	}
+	stop, err := nearestOnLine(coords, stopPt, constants.UnitDefault)
+	if err != nil {
+		return nil, err
+	}
+	if start.location > stop.location {
+		start, stop = stop, start
+	}
+
+	points := []geometry.Point{start.point}
+	for i := start.index + 1; i <= stop.index; i++ {
+		if coords[i] != points[len(points)-1] {
+			points = append(points, coords[i])
+		}
+	}
+	if stop.point != points[len(points)-1] || len(points) == 1 {
+		points = append(points, stop.point)
+	}
+
+	return lineFeature(points, properties(line))
+}
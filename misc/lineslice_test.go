@@ -0,0 +1,56 @@
+package misc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestLineSlice(t *testing.T) {
+	f, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: [][]float64{{0, 0}, {1, 0}, {2, 0}, {3, 0}},
+	}, nil, map[string]interface{}{"route": "42"}, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+
+	s, err := LineSlice(geometry.Point{Lng: 0.5, Lat: 0.1}, geometry.Point{Lng: 2.5, Lat: -0.1}, f)
+	if err != nil {
+		t.Errorf("LineSlice error %v", err)
+		return
+	}
+	assert.Equal(t, s.Geometry.GeoJSONType, geojson.LineString)
+	assert.Equal(t, s.Properties["route"], "42")
+	coords := s.Geometry.Coordinates.([][]float64)
+	assert.Equal(t, len(coords), 4)
+	assert.True(t, math.Abs(coords[0][0]-0.5) < 1e-3 && math.Abs(coords[0][1]) < 1e-3)
+	assert.Equal(t, coords[1], []float64{1, 0})
+	assert.Equal(t, coords[2], []float64{2, 0})
+	assert.True(t, math.Abs(coords[3][0]-2.5) < 1e-3 && math.Abs(coords[3][1]) < 1e-3)
+
+	// the points in reverse order keep the vertex order of the line
+	r, err := LineSlice(geometry.Point{Lng: 2.5, Lat: -0.1}, geometry.Point{Lng: 0.5, Lat: 0.1}, f)
+	if err != nil {
+		t.Errorf("LineSlice error %v", err)
+		return
+	}
+	assert.Equal(t, r.Geometry.Coordinates, s.Geometry.Coordinates)
+
+	// both points on the same segment
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}}}
+	s, err = LineSlice(geometry.Point{Lng: 0.2, Lat: 0}, geometry.Point{Lng: 0.4, Lat: 0}, ln)
+	if err != nil {
+		t.Errorf("LineSlice error %v", err)
+		return
+	}
+	assert.Equal(t, len(s.Geometry.Coordinates.([][]float64)), 2)
+
+	_, err = LineSlice(geometry.Point{}, geometry.Point{}, geometry.Point{})
+	assert.True(t, err != nil)
+}
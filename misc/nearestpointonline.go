@@ -0,0 +1,122 @@
+package misc
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// nearest is the closest point of a line to a point.
+type nearest struct {
+	point geometry.Point
+	// index of the vertex at the start of the closest segment
+	index int
+	// dist from the point to the line
+	dist float64
+	// location is the distance along the line from its start
+	location float64
+}
+
+// NearestPointOnLine takes a LineString or MultiLineString and a point and returns the closest point on the line as
+// a Point Feature.
+// The Feature has the properties "index", the index of the vertex at the start of the closest segment, "dist", the
+// distance from the point, and "location", the distance along the line from its start. The parts of a MultiLineString
+// are walked one after the other and the index is counted across them.
+// ref. http://turfjs.org/docs/#nearestPointOnLine
+func NearestPointOnLine(line interface{}, pt geometry.Point, units string) (*feature.Feature, error) {
+	lines, err := lineStrings(line)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *nearest
+	offset := 0
+	travelled := 0.0
+	for _, l := range lines {
+		n, err := nearestOnLine(l.Coordinates, pt, units)
+		if err != nil {
+			return nil, err
+		}
+		if best == nil || n.dist < best.dist {
+			n.index += offset
+			n.location += travelled
+			best = n
+		}
+		length, err := measurement.Length(l, units)
+		if err != nil {
+			return nil, err
+		}
+		offset += len(l.Coordinates)
+		travelled += length
+	}
+	if best == nil {
+		return nil, errors.New("line is required")
+	}
+
+	return pointFeature(best.point, map[string]interface{}{
+		"index":    best.index,
+		"dist":     best.dist,
+		"location": best.location,
+	})
+}
+
+// nearestOnLine returns the closest point of the line to pt.
+func nearestOnLine(coords []geometry.Point, pt geometry.Point, units string) (*nearest, error) {
+	if len(coords) == 0 {
+		return nil, errors.New("line is required")
+	}
+	if len(coords) == 1 {
+		d, err := measurement.PointDistance(pt, coords[0], units)
+		if err != nil {
+			return nil, err
+		}
+		return &nearest{point: coords[0], dist: d}, nil
+	}
+	best := nearest{point: coords[0], dist: math.Inf(1)}
+	travelled := 0.0
+	for i := 0; i < len(coords)-1; i++ {
+		p, along, length, err := nearestOnSegment(coords[i], coords[i+1], pt, units)
+		if err != nil {
+			return nil, err
+		}
+		d, err := measurement.PointDistance(pt, p, units)
+		if err != nil {
+			return nil, err
+		}
+		if d < best.dist {
+			best = nearest{point: p, index: i, dist: d, location: travelled + along}
+		}
+		travelled += length
+	}
+	return &best, nil
+}
+
+// nearestOnSegment returns the closest point of the great circle segment a-b to pt, its distance from a and the
+// length of the segment.
+func nearestOnSegment(a geometry.Point, b geometry.Point, pt geometry.Point, units string) (geometry.Point, float64, float64, error) {
+	length, err := measurement.PointDistance(a, b, units)
+	if err != nil {
+		return geometry.Point{}, 0.0, 0.0, err
+	}
+	if length == 0 {
+		return a, 0.0, 0.0, nil
+	}
+	along, err := measurement.AlongTrackDistance(pt, a, b, units)
+	if err != nil {
+		return geometry.Point{}, 0.0, 0.0, err
+	}
+	if along <= 0 {
+		return a, 0.0, length, nil
+	}
+	if along >= length {
+		return b, length, length, nil
+	}
+	p, err := measurement.Destination(a, along, measurement.PointBearing(a, b), units)
+	if err != nil {
+		return geometry.Point{}, 0.0, 0.0, err
+	}
+	return *p, along, length, nil
+}
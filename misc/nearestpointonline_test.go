@@ -0,0 +1,53 @@
+package misc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestNearestPointOnLine(t *testing.T) {
+	ln := geometry.LineString{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 1, Lat: 1}}}
+
+	f, err := NearestPointOnLine(ln, geometry.Point{Lng: 0.5, Lat: 0.1}, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("NearestPointOnLine error %v", err)
+		return
+	}
+	c := f.Geometry.Coordinates.([]float64)
+	assert.True(t, math.Abs(c[0]-0.5) < 1e-3 && math.Abs(c[1]) < 1e-3)
+	assert.Equal(t, f.Properties["index"], 0)
+	assert.True(t, math.Abs(f.Properties["dist"].(float64)-11.12) < 0.01)
+	assert.True(t, math.Abs(f.Properties["location"].(float64)-55.6) < 0.1)
+
+	f, err = NearestPointOnLine(ln, geometry.Point{Lng: 1.2, Lat: 0.5}, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("NearestPointOnLine error %v", err)
+		return
+	}
+	c = f.Geometry.Coordinates.([]float64)
+	assert.True(t, math.Abs(c[0]-1) < 1e-9 && math.Abs(c[1]-0.5) < 1e-3)
+	assert.Equal(t, f.Properties["index"], 1)
+
+	// beyond the end of the line
+	f, err = NearestPointOnLine(ln, geometry.Point{Lng: 1, Lat: 2}, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("NearestPointOnLine error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.Coordinates, []float64{1, 1})
+
+	ml := geometry.MultiLineString{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}}},
+		{Coordinates: []geometry.Point{{Lng: 0, Lat: 1}, {Lng: 1, Lat: 1}}},
+	}}
+	f, err = NearestPointOnLine(ml, geometry.Point{Lng: 0.5, Lat: 0.9}, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("NearestPointOnLine error %v", err)
+		return
+	}
+	assert.Equal(t, f.Properties["index"], 2)
+}
@@ -0,0 +1,106 @@
+package misc
+
+import (
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/et-soft/turf-go/transformation"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// Sector creates a circle sector Polygon between two bearings, clockwise from bearing1 to bearing2, with the radius
+// from the center. The sector is a circle when the bearings are equal.
+// The options are the Circle options, Steps is the number of segments of the arc (64 by default) and Units the units
+// of the radius (kilometers by default).
+// ref. http://turfjs.org/docs/#sector
+func Sector(center geometry.Point, radius float64, bearing1 float64, bearing2 float64, options *transformation.CircleOptions) (*feature.Feature, error) {
+	options = arcDefaults(options)
+	arc, err := arcPoints(center, radius, bearing1, bearing2, options)
+	if err != nil {
+		return nil, err
+	}
+
+	ring := arc
+	if !fullCircle(bearing1, bearing2) {
+		ring = append([]geometry.Point{center}, arc...)
+		ring = append(ring, center)
+	}
+	g := geometry.Geometry{
+		GeoJSONType: geojson.Polygon,
+		Coordinates: [][][]float64{pointsToCoords(ring)},
+	}
+	return feature.New(g, bbox(ring), copyMap(options.Properties), "")
+}
+
+// LineArc creates a circular arc LineString between two bearings, clockwise from bearing1 to bearing2, with the
+// radius from the center. The arc is a full circle when the bearings are equal.
+// The options are the Circle options, Steps is the number of segments of the arc (64 by default) and Units the units
+// of the radius (kilometers by default).
+// ref. http://turfjs.org/docs/#lineArc
+func LineArc(center geometry.Point, radius float64, bearing1 float64, bearing2 float64, options *transformation.CircleOptions) (*feature.Feature, error) {
+	options = arcDefaults(options)
+	arc, err := arcPoints(center, radius, bearing1, bearing2, options)
+	if err != nil {
+		return nil, err
+	}
+	return lineFeature(arc, copyMap(options.Properties))
+}
+
+// arcDefaults returns a copy of the options with the default steps and units.
+func arcDefaults(options *transformation.CircleOptions) *transformation.CircleOptions {
+	o := transformation.CircleOptions{}
+	if options != nil {
+		o = *options
+	}
+	if o.Steps <= 0 {
+		o.Steps = 64
+	}
+	if o.Units == "" {
+		o.Units = constants.UnitKilometers
+	}
+	return &o
+}
+
+// arcPoints returns the steps+1 points of the arc, at the geodesic destinations of the center along the bearings.
+func arcPoints(center geometry.Point, radius float64, bearing1 float64, bearing2 float64, options *transformation.CircleOptions) ([]geometry.Point, error) {
+	if radius <= 0 {
+		return nil, errors.New("radius must be greater than 0")
+	}
+	start := normalizeBearing(bearing1)
+	end := normalizeBearing(bearing2)
+	if end <= start {
+		end += 360
+	}
+
+	points := []geometry.Point{}
+	for i := 0; i <= options.Steps; i++ {
+		bearing := start + (end-start)*float64(i)/float64(options.Steps)
+		p, err := measurement.Destination(center, radius, bearing, options.Units)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, *p)
+	}
+	if fullCircle(bearing1, bearing2) {
+		// close the ring on exactly the same position
+		points[len(points)-1] = points[0]
+	}
+	return points, nil
+}
+
+// normalizeBearing returns the bearing in the range [0, 360).
+func normalizeBearing(bearing float64) float64 {
+	b := math.Mod(bearing, 360)
+	if b < 0 {
+		b += 360
+	}
+	return b
+}
+
+func fullCircle(bearing1 float64, bearing2 float64) bool {
+	return normalizeBearing(bearing1) == normalizeBearing(bearing2)
+}
@@ -0,0 +1,99 @@
+package misc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/et-soft/turf-go/transformation"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestSector(t *testing.T) {
+	center := geometry.Point{Lng: -75, Lat: 40}
+	options := &transformation.CircleOptions{Steps: 8, Units: constants.UnitKilometers, Properties: map[string]interface{}{"antenna": "A1"}}
+
+	f, err := Sector(center, 5, 25, 45, options)
+	if err != nil {
+		t.Errorf("Sector error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.GeoJSONType, geojson.Polygon)
+	assert.Equal(t, f.Properties["antenna"], "A1")
+	poly, err := f.ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error %v", err)
+		return
+	}
+	ring := poly.Coordinates[0].Coordinates
+	assert.Equal(t, len(ring), 11)
+	assert.Equal(t, ring[0], center)
+	assert.Equal(t, ring[10], center)
+	for _, p := range ring[1:10] {
+		d, err := measurement.PointDistance(center, p, constants.UnitKilometers)
+		if err != nil {
+			t.Errorf("PointDistance error %v", err)
+			return
+		}
+		assert.True(t, math.Abs(d-5) < 1e-6)
+	}
+	assert.True(t, math.Abs(measurement.PointBearing(center, ring[1])-25) < 1e-6)
+	assert.True(t, math.Abs(measurement.PointBearing(center, ring[9])-45) < 1e-6)
+
+	// the sector crosses north clockwise
+	f, err = Sector(center, 5, 315, 45, nil)
+	if err != nil {
+		t.Errorf("Sector error %v", err)
+		return
+	}
+	poly, err = f.ToPolygon()
+	if err != nil {
+		t.Errorf("ToPolygon error %v", err)
+		return
+	}
+	ring = poly.Coordinates[0].Coordinates
+	assert.Equal(t, len(ring), 67)
+	assert.True(t, math.Abs(ring[33].Lng-center.Lng) < 1e-9)
+	assert.True(t, ring[33].Lat > center.Lat)
+
+	_, err = Sector(center, 0, 25, 45, nil)
+	assert.True(t, err != nil)
+}
+
+func TestLineArc(t *testing.T) {
+	center := geometry.Point{Lng: 0, Lat: 0}
+
+	f, err := LineArc(center, 10, 90, 180, &transformation.CircleOptions{Steps: 2})
+	if err != nil {
+		t.Errorf("LineArc error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.GeoJSONType, geojson.LineString)
+	ln, err := f.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+		return
+	}
+	assert.Equal(t, len(ln.Coordinates), 3)
+	assert.True(t, math.Abs(ln.Coordinates[0].Lng-0.0899322) < 1e-6)
+	assert.True(t, math.Abs(ln.Coordinates[0].Lat) < 1e-9)
+	assert.True(t, math.Abs(ln.Coordinates[2].Lng) < 1e-9)
+	assert.True(t, math.Abs(ln.Coordinates[2].Lat+0.0899322) < 1e-6)
+
+	// equal bearings make a closed circle
+	f, err = LineArc(center, 10, -90, 270, nil)
+	if err != nil {
+		t.Errorf("LineArc error %v", err)
+		return
+	}
+	ln, err = f.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+		return
+	}
+	assert.Equal(t, len(ln.Coordinates), 65)
+	assert.Equal(t, ln.Coordinates[0], ln.Coordinates[64])
+}
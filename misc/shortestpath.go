@@ -0,0 +1,246 @@
+package misc
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// boundaryTolerance is the distance in degrees under which a point is on an obstacle boundary.
+const boundaryTolerance = 1e-9
+
+// ShortestPathOptions contains options for the ShortestPath function
+type ShortestPathOptions struct {
+	Units      string                 `json:"units,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// ShortestPath returns the shortest path from start to end avoiding the obstacle polygons as a LineString Feature with
+// the properties of the options and the path length in the "length" property.
+// obstacles can be a Polygon | MultiPolygon | Geometry | Feature | FeatureCollection, or nil for no obstacles.
+// The path is searched in a visibility graph of the start, the end and the obstacle vertices: two of them are
+// connected when the straight segment between them, in longitude and latitude, does not pass through an obstacle.
+// The path can follow the obstacle boundaries. The edges are weighted with their distance in the units of the
+// options, kilometers by default.
+// With V obstacle vertices and E obstacle edges the graph has O(V²) candidate edges and testing the visibility of one
+// costs O(E), so the search takes O(V²·E) in the worst case. The visibility is only tested for the edges that would
+// shorten the path to a node, which keeps a few hundred vertices practical but not thousands.
+// ref. http://turfjs.org/docs/#shortestPath
+func ShortestPath(start geometry.Point, end geometry.Point, obstacles interface{}, options *ShortestPathOptions) (*feature.Feature, error) {
+	if options == nil {
+		options = &ShortestPathOptions{}
+	}
+	units := options.Units
+	if units == "" {
+		units = constants.UnitKilometers
+	}
+
+	polys := []polygonWithProperties{}
+	if obstacles != nil {
+		var err error
+		polys, err = polygonsOf(obstacles)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if insideObstacle(start, polys) {
+		return nil, errors.New("start point is inside an obstacle")
+	}
+	if insideObstacle(end, polys) {
+		return nil, errors.New("end point is inside an obstacle")
+	}
+
+	edges := []segment{}
+	nodes := []geometry.Point{start, end}
+	seen := map[geometry.Point]bool{start: true, end: true}
+	for _, p := range polys {
+		for _, r := range p.polygon.Coordinates {
+			edges = append(edges, segmentsOf([][]geometry.Point{r.Coordinates})...)
+			for _, v := range r.Coordinates {
+				if !seen[v] {
+					seen[v] = true
+					nodes = append(nodes, v)
+				}
+			}
+		}
+	}
+
+	// Dijkstra over the visibility graph, the edges are tested when their node is settled
+	dist := make([]float64, len(nodes))
+	prev := make([]int, len(nodes))
+	done := make([]bool, len(nodes))
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		prev[i] = -1
+	}
+	dist[0] = 0
+	if start == end {
+		dist[1] = 0
+	}
+	q := &pathQueue{{node: 0}}
+	for q.Len() > 0 {
+		u := heap.Pop(q).(pathItem).node
+		if done[u] {
+			continue
+		}
+		done[u] = true
+		if u == 1 {
+			break
+		}
+		for v := range nodes {
+			if done[v] {
+				continue
+			}
+			d, err := measurement.PointDistance(nodes[u], nodes[v], units)
+			if err != nil {
+				return nil, err
+			}
+			// the visibility is the expensive test, only for the edges shortening the path
+			if dist[u]+d >= dist[v] || !visible(segment{a: nodes[u], b: nodes[v]}, edges, polys) {
+				continue
+			}
+			dist[v] = dist[u] + d
+			prev[v] = u
+			heap.Push(q, pathItem{node: v, dist: dist[v]})
+		}
+	}
+	if math.IsInf(dist[1], 1) {
+		return nil, errors.New("no path between start and end")
+	}
+
+	path := []geometry.Point{}
+	for i := 1; i != -1; i = prev[i] {
+		path = append([]geometry.Point{nodes[i]}, path...)
+	}
+	if len(path) == 1 {
+		// start and end are the same point
+		path = append(path, end)
+	}
+	props := copyMap(options.Properties)
+	props["length"] = dist[1]
+	return lineFeature(path, props)
+}
+
+// pathItem is a node of the search queue with its distance from the start.
+type pathItem struct {
+	node int
+	dist float64
+}
+
+// pathQueue is a min-heap of nodes by distance, a node is pushed again when its distance decreases.
+type pathQueue []pathItem
+
+func (q pathQueue) Len() int            { return len(q) }
+func (q pathQueue) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q pathQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *pathQueue) Push(x interface{}) { *q = append(*q, x.(pathItem)) }
+func (q *pathQueue) Pop() interface{} {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
+
+// visible returns true when the segment does not cross an obstacle edge and does not pass through the interior of an
+// obstacle, it can touch the obstacle vertices and run along their boundary.
+func visible(s segment, edges []segment, polys []polygonWithProperties) bool {
+	if s.a == s.b {
+		return false
+	}
+	sb := s.box()
+	cuts := []float64{0, 1}
+	for _, e := range edges {
+		eb := e.box()
+		if eb.minX > sb.maxX || eb.maxX < sb.minX || eb.minY > sb.maxY || eb.maxY < sb.minY {
+			continue
+		}
+		uA, uB, ok := segmentParams(s, e)
+		if ok {
+			if uA > 0 && uA < 1 && uB > 0 && uB < 1 && !onBoundary(s.a, e) && !onBoundary(s.b, e) {
+				return false
+			}
+			cuts = append(cuts, uA)
+			continue
+		}
+		// the vertices of the collinear edges split the segment
+		for _, v := range []geometry.Point{e.a, e.b} {
+			if onBoundary(v, s) {
+				cuts = append(cuts, alongSegment(v, s))
+			}
+		}
+	}
+
+	// the pieces of the segment between the touching points are either inside or outside the obstacles
+	sort.Float64s(cuts)
+	for i := 1; i < len(cuts); i++ {
+		if cuts[i]-cuts[i-1] < 1e-12 {
+			continue
+		}
+		u := (cuts[i-1] + cuts[i]) / 2
+		m := geometry.Point{Lng: s.a.Lng + u*(s.b.Lng-s.a.Lng), Lat: s.a.Lat + u*(s.b.Lat-s.a.Lat)}
+		if insideObstacle(m, polys) {
+			return false
+		}
+	}
+	return true
+}
+
+// insideObstacle returns true when the point is in the interior of an obstacle, the boundary is outside.
+func insideObstacle(p geometry.Point, polys []polygonWithProperties) bool {
+	for _, poly := range polys {
+		rings := poly.polygon.Coordinates
+		if len(rings) == 0 || !pointInRing(p, rings[0].Coordinates) {
+			continue
+		}
+		inHole := false
+		for _, r := range rings[1:] {
+			if pointInRing(p, r.Coordinates) {
+				inHole = true
+				break
+			}
+		}
+		if inHole {
+			continue
+		}
+		onEdge := false
+		for _, r := range rings {
+			for _, e := range segmentsOf([][]geometry.Point{r.Coordinates}) {
+				if onBoundary(p, e) {
+					onEdge = true
+					break
+				}
+			}
+		}
+		if !onEdge {
+			return true
+		}
+	}
+	return false
+}
+
+// onBoundary returns true when the point is on the segment within the boundary tolerance.
+func onBoundary(p geometry.Point, s segment) bool {
+	dx, dy := s.b.Lng-s.a.Lng, s.b.Lat-s.a.Lat
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return math.Hypot(p.Lng-s.a.Lng, p.Lat-s.a.Lat) <= boundaryTolerance
+	}
+	cross := (p.Lng-s.a.Lng)*dy - (p.Lat-s.a.Lat)*dx
+	if math.Abs(cross)/length > boundaryTolerance {
+		return false
+	}
+	u := alongSegment(p, s)
+	return u >= -boundaryTolerance/length && u <= 1+boundaryTolerance/length
+}
+
+// alongSegment returns the position of the projection of the point on the segment, from 0 at its start to 1 at its end.
+func alongSegment(p geometry.Point, s segment) float64 {
+	dx, dy := s.b.Lng-s.a.Lng, s.b.Lat-s.a.Lat
+	return dot(p.Lng-s.a.Lng, p.Lat-s.a.Lat, dx, dy) / dot(dx, dy, dx, dy)
+}
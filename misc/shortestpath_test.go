@@ -0,0 +1,86 @@
+package misc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+func TestShortestPath(t *testing.T) {
+	start := geometry.Point{Lng: 0, Lat: 0}
+	end := geometry.Point{Lng: 3, Lat: 0}
+
+	// without obstacles the path is straight
+	f, err := ShortestPath(start, end, nil, nil)
+	if err != nil {
+		t.Errorf("ShortestPath error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.GeoJSONType, geojson.LineString)
+	assert.Equal(t, f.Geometry.Coordinates, [][]float64{{0, 0}, {3, 0}})
+	d, err := measurement.PointDistance(start, end, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("PointDistance error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(f.Properties["length"].(float64)-d) < 1e-9)
+
+	// the path goes around the short side of the obstacle along its boundary
+	obstacle, err := feature.New(geometry.Geometry{
+		GeoJSONType: geojson.Polygon,
+		Coordinates: [][][]float64{{{1, -1}, {2, -1}, {2, 2}, {1, 2}, {1, -1}}},
+	}, nil, nil, "")
+	if err != nil {
+		t.Errorf("feature error %v", err)
+		return
+	}
+	f, err = ShortestPath(start, end, obstacle, &ShortestPathOptions{Units: constants.UnitMeters, Properties: map[string]interface{}{"drone": "D1"}})
+	if err != nil {
+		t.Errorf("ShortestPath error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.Coordinates, [][]float64{{0, 0}, {1, -1}, {2, -1}, {3, 0}})
+	assert.Equal(t, f.Properties["drone"], "D1")
+	ln, err := f.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+		return
+	}
+	length, err := measurement.Length(*ln, constants.UnitMeters)
+	if err != nil {
+		t.Errorf("Length error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(f.Properties["length"].(float64)-length) < 1e-6)
+
+	// a concave obstacle is not crossed through its notch
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": {}, \"geometry\": { \"type\": \"Polygon\", \"coordinates\": " +
+		"[[[1, -3.5], [2, -3.5], [2, 3], [1, 3], [1, 2], [1.5, 2], [1.5, -2], [1, -2], [1, -3.5]]] } }]}")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+		return
+	}
+	f, err = ShortestPath(start, end, fc, nil)
+	if err != nil {
+		t.Errorf("ShortestPath error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.Coordinates, [][]float64{{0, 0}, {1, 3}, {2, 3}, {3, 0}})
+
+	// an end inside an obstacle or enclosed in its hole
+	_, err = ShortestPath(start, geometry.Point{Lng: 1.5, Lat: 0.5}, obstacle, nil)
+	assert.True(t, err != nil)
+	ring := geometry.Polygon{Coordinates: []geometry.LineString{
+		{Coordinates: []geometry.Point{{Lng: 2, Lat: -2}, {Lng: 4, Lat: -2}, {Lng: 4, Lat: 2}, {Lng: 2, Lat: 2}, {Lng: 2, Lat: -2}}},
+		{Coordinates: []geometry.Point{{Lng: 2.5, Lat: -1}, {Lng: 3.5, Lat: -1}, {Lng: 3.5, Lat: 1}, {Lng: 2.5, Lat: 1}, {Lng: 2.5, Lat: -1}}},
+	}}
+	_, err = ShortestPath(start, end, ring, nil)
+	assert.True(t, err != nil)
+}
@@ -0,0 +1,249 @@
+package network
+
+import (
+	"math"
+
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// triangle is a counterclockwise triangle of point indices.
+type triangle struct {
+	a, b, c int
+}
+
+// concaveHull returns the rings of the concave hull of the points, the union of the triangles of their Delaunay
+// triangulation with edges not longer than maxEdge in the given units. The outer rings are counterclockwise and the
+// holes clockwise, each outer ring is followed by its holes. It returns no rings when no triangle is kept.
+func concaveHull(points []geometry.Point, maxEdge float64, units string) ([][][]geometry.Point, error) {
+	unique := []geometry.Point{}
+	seen := map[geometry.Point]bool{}
+	for _, p := range points {
+		if !seen[p] {
+			seen[p] = true
+			unique = append(unique, p)
+		}
+	}
+
+	kept := []triangle{}
+	for _, t := range delaunay(unique) {
+		short := true
+		for _, e := range [][2]int{{t.a, t.b}, {t.b, t.c}, {t.c, t.a}} {
+			d, err := measurement.PointDistance(unique[e[0]], unique[e[1]], units)
+			if err != nil {
+				return nil, err
+			}
+			if d > maxEdge {
+				short = false
+				break
+			}
+		}
+		if short {
+			kept = append(kept, t)
+		}
+	}
+	return hullRings(unique, kept), nil
+}
+
+// delaunay returns the Delaunay triangulation of distinct points in longitude and latitude, with Bowyer-Watson.
+func delaunay(points []geometry.Point) []triangle {
+	if len(points) < 3 {
+		return nil
+	}
+	minX, minY, maxX, maxY := points[0].Lng, points[0].Lat, points[0].Lng, points[0].Lat
+	for _, p := range points {
+		minX, minY = math.Min(minX, p.Lng), math.Min(minY, p.Lat)
+		maxX, maxY = math.Max(maxX, p.Lng), math.Max(maxY, p.Lat)
+	}
+	size := math.Max(maxX-minX, maxY-minY)
+	if size == 0 {
+		return nil
+	}
+	midX, midY := (minX+maxX)/2, (minY+maxY)/2
+
+	// the super triangle containing all the points has the last three indices
+	n := len(points)
+	all := append(append([]geometry.Point{}, points...),
+		geometry.Point{Lng: midX - 20*size, Lat: midY - size},
+		geometry.Point{Lng: midX + 20*size, Lat: midY - size},
+		geometry.Point{Lng: midX, Lat: midY + 20*size})
+	triangles := []triangle{{n, n + 1, n + 2}}
+
+	for i := 0; i < n; i++ {
+		p := all[i]
+		count := map[[2]int]int{}
+		edges := [][2]int{}
+		remaining := []triangle{}
+		for _, t := range triangles {
+			if !inCircumcircle(p, all[t.a], all[t.b], all[t.c]) {
+				remaining = append(remaining, t)
+				continue
+			}
+			for _, e := range [][2]int{{t.a, t.b}, {t.b, t.c}, {t.c, t.a}} {
+				count[undirected(e)]++
+				edges = append(edges, e)
+			}
+		}
+		triangles = remaining
+		// the edges of the cavity are the edges of a single removed triangle
+		for _, e := range edges {
+			if count[undirected(e)] != 1 {
+				continue
+			}
+			t := triangle{e[0], e[1], i}
+			if cross(all[t.a], all[t.b], all[t.c]) < 0 {
+				t.a, t.b = t.b, t.a
+			}
+			triangles = append(triangles, t)
+		}
+	}
+
+	result := []triangle{}
+	for _, t := range triangles {
+		if t.a < n && t.b < n && t.c < n && cross(points[t.a], points[t.b], points[t.c]) > 0 {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// inCircumcircle returns true when p is inside the circumcircle of the counterclockwise triangle a, b, c.
+func inCircumcircle(p geometry.Point, a geometry.Point, b geometry.Point, c geometry.Point) bool {
+	ax, ay := a.Lng-p.Lng, a.Lat-p.Lat
+	bx, by := b.Lng-p.Lng, b.Lat-p.Lat
+	cx, cy := c.Lng-p.Lng, c.Lat-p.Lat
+	det := (ax*ax+ay*ay)*(bx*cy-cx*by) - (bx*bx+by*by)*(ax*cy-cx*ay) + (cx*cx+cy*cy)*(ax*by-bx*ay)
+	return det > 0
+}
+
+// cross returns the cross product of a-o and b-o, positive when o, a, b turn counterclockwise.
+func cross(o geometry.Point, a geometry.Point, b geometry.Point) float64 {
+	return (a.Lng-o.Lng)*(b.Lat-o.Lat) - (a.Lat-o.Lat)*(b.Lng-o.Lng)
+}
+
+// undirected returns the edge with the smaller index first.
+func undirected(e [2]int) [2]int {
+	if e[0] > e[1] {
+		return [2]int{e[1], e[0]}
+	}
+	return e
+}
+
+// hullRings returns the rings of the boundary of the union of the triangles, each outer ring followed by its holes.
+func hullRings(points []geometry.Point, triangles []triangle) [][][]geometry.Point {
+	count := map[[2]int]int{}
+	for _, t := range triangles {
+		for _, e := range [][2]int{{t.a, t.b}, {t.b, t.c}, {t.c, t.a}} {
+			count[undirected(e)]++
+		}
+	}
+	// the boundary edges keep the direction of their triangle, the union is on their left
+	next := map[int][]int{}
+	boundary := [][2]int{}
+	for _, t := range triangles {
+		for _, e := range [][2]int{{t.a, t.b}, {t.b, t.c}, {t.c, t.a}} {
+			if count[undirected(e)] == 1 {
+				next[e[0]] = append(next[e[0]], e[1])
+				boundary = append(boundary, e)
+			}
+		}
+	}
+
+	outers := [][]geometry.Point{}
+	holes := [][]geometry.Point{}
+	for _, e := range boundary {
+		if !contains(next[e[0]], e[1]) {
+			continue
+		}
+		ring := []geometry.Point{points[e[0]]}
+		for from, to := e[0], e[1]; ; {
+			next[from] = remove(next[from], to)
+			ring = append(ring, points[to])
+			if to == e[0] || len(next[to]) == 0 {
+				break
+			}
+			from, to = to, next[to][0]
+		}
+		if len(ring) < 4 || ring[len(ring)-1] != ring[0] {
+			continue
+		}
+		if signedArea(ring) > 0 {
+			outers = append(outers, ring)
+		} else {
+			holes = append(holes, ring)
+		}
+	}
+
+	polygons := [][][]geometry.Point{}
+	for _, o := range outers {
+		polygons = append(polygons, [][]geometry.Point{o})
+	}
+	for _, h := range holes {
+		for i := range polygons {
+			if pointInRing(h[0], polygons[i][0]) || pointInRing(h[1], polygons[i][0]) {
+				polygons[i] = append(polygons[i], h)
+				break
+			}
+		}
+	}
+	return polygons
+}
+
+// contains reports whether the index is in the indices.
+func contains(indices []int, i int) bool {
+	for _, j := range indices {
+		if j == i {
+			return true
+		}
+	}
+	return false
+}
+
+// remove returns the indices without the index, the indices are not modified.
+func remove(indices []int, i int) []int {
+	for k, j := range indices {
+		if j == i {
+			return append(indices[:k:k], indices[k+1:]...)
+		}
+	}
+	return indices
+}
+
+// signedArea returns the planar area of a closed ring, positive when it is counterclockwise.
+func signedArea(ring []geometry.Point) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1].Lng*ring[i].Lat - ring[i].Lng*ring[i-1].Lat
+	}
+	return area / 2
+}
+
+// pointInRing reports whether the point is inside the ring with the ray casting test.
+func pointInRing(p geometry.Point, ring []geometry.Point) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a := ring[i]
+		b := ring[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) && p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// polygonGeometry returns the rings as a Polygon, or a MultiPolygon when there are several outer rings.
+func polygonGeometry(polygons [][][]geometry.Point) geometry.Geometry {
+	coords := [][][][]float64{}
+	for _, p := range polygons {
+		rings := [][][]float64{}
+		for _, r := range p {
+			rings = append(rings, pointsToCoords(r))
+		}
+		coords = append(coords, rings)
+	}
+	if len(coords) == 1 {
+		return geometry.Geometry{GeoJSONType: geojson.Polygon, Coordinates: coords[0]}
+	}
+	return geometry.Geometry{GeoJSONType: geojson.MultiPolygon, Coordinates: coords}
+}
@@ -0,0 +1,318 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// Options contains options for the New function
+type Options struct {
+	// Units of the lengths of the network, kilometers by default
+	Units string `json:"units,omitempty"`
+	// WeightProperty is the numeric property with the cost of traversing a feature, the lengths are used without it.
+	// The cost is shared between the segments of the feature in proportion to their length.
+	WeightProperty string `json:"weightProperty,omitempty"`
+	// SpeedProperty is the numeric property with the speed on a feature in units of the network per hour, the weights
+	// are then the travel times in minutes.
+	SpeedProperty string `json:"speedProperty,omitempty"`
+	// OneWayProperty is the property marking the features traversable only in one direction: true, 1, "yes" or
+	// "true" in the direction of the line and -1 or "-1" in the opposite direction.
+	OneWayProperty string `json:"oneWayProperty,omitempty"`
+}
+
+// Graph is a routable network of lines, its nodes are the line vertices, the lines sharing a vertex are connected.
+type Graph struct {
+	nodes    []geometry.Point
+	index    map[geometry.Point]int
+	adjacent [][]edge
+	links    []link
+	units    string
+	// weighted is true when the edge weights are not the lengths
+	weighted bool
+}
+
+// edge is a directed connection of the graph.
+type edge struct {
+	to     int
+	weight float64
+	length float64
+}
+
+// link is a segment between two consecutive vertices of a line, with the weights of its two directions. A direction
+// that can't be traversed has an infinite weight.
+type link struct {
+	from     int
+	to       int
+	length   float64
+	forward  float64
+	backward float64
+}
+
+// New builds the graph of a FeatureCollection of LineString or MultiLineString Features.
+func New(fc interface{}, options *Options) (*Graph, error) {
+	if options == nil {
+		options = &Options{}
+	}
+	var features []feature.Feature
+	switch gtp := fc.(type) {
+	case feature.Collection:
+		features = gtp.Features
+	case *feature.Collection:
+		features = gtp.Features
+	default:
+		return nil, errors.New("a FeatureCollection is required")
+	}
+
+	g := &Graph{
+		index:    map[geometry.Point]int{},
+		units:    options.Units,
+		weighted: options.WeightProperty != "" || options.SpeedProperty != "",
+	}
+	if options.WeightProperty != "" && options.SpeedProperty != "" {
+		return nil, errors.New("the weight and the speed properties can't be used together")
+	}
+	if g.units == "" {
+		g.units = constants.UnitKilometers
+	}
+	for i := range features {
+		if err := g.addFeature(&features[i], options); err != nil {
+			return nil, err
+		}
+	}
+	if len(g.links) == 0 {
+		return nil, errors.New("the network has no lines")
+	}
+	return g, nil
+}
+
+func (g *Graph) addFeature(f *feature.Feature, options *Options) error {
+	lines, err := lineStrings(&f.Geometry)
+	if err != nil {
+		return err
+	}
+	forward, backward, err := directions(f.Properties, options.OneWayProperty)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range lines {
+		lengths := []float64{}
+		total := 0.0
+		for i := 1; i < len(l.Coordinates); i++ {
+			d, err := measurement.PointDistance(l.Coordinates[i-1], l.Coordinates[i], g.units)
+			if err != nil {
+				return err
+			}
+			lengths = append(lengths, d)
+			total += d
+		}
+
+		// the cost per unit of length of the line
+		rate := 1.0
+		if options.WeightProperty != "" {
+			w, ok := number(f.Properties[options.WeightProperty])
+			if !ok || w < 0 {
+				return fmt.Errorf("the %s property must be a positive number", options.WeightProperty)
+			}
+			if total > 0 {
+				rate = w / total
+			}
+		}
+		if options.SpeedProperty != "" {
+			v, ok := number(f.Properties[options.SpeedProperty])
+			if !ok || v <= 0 {
+				return fmt.Errorf("the %s property must be a positive number", options.SpeedProperty)
+			}
+			rate = 60 / v
+		}
+
+		for i, d := range lengths {
+			lk := link{
+				from:     g.node(l.Coordinates[i]),
+				to:       g.node(l.Coordinates[i+1]),
+				length:   d,
+				forward:  math.Inf(1),
+				backward: math.Inf(1),
+			}
+			if lk.from == lk.to {
+				continue
+			}
+			if forward {
+				lk.forward = d * rate
+				g.adjacent[lk.from] = append(g.adjacent[lk.from], edge{to: lk.to, weight: lk.forward, length: d})
+			}
+			if backward {
+				lk.backward = d * rate
+				g.adjacent[lk.to] = append(g.adjacent[lk.to], edge{to: lk.from, weight: lk.backward, length: d})
+			}
+			g.links = append(g.links, lk)
+		}
+	}
+	return nil
+}
+
+// number returns the value of a numeric property.
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// node returns the index of the node at the point, a new node is added for a new point.
+func (g *Graph) node(p geometry.Point) int {
+	if i, ok := g.index[p]; ok {
+		return i
+	}
+	g.index[p] = len(g.nodes)
+	g.nodes = append(g.nodes, p)
+	g.adjacent = append(g.adjacent, []edge{})
+	return len(g.nodes) - 1
+}
+
+// directions returns the directions in which a feature can be traversed.
+func directions(properties map[string]interface{}, oneWayProperty string) (bool, bool, error) {
+	if oneWayProperty == "" {
+		return true, true, nil
+	}
+	switch v := properties[oneWayProperty].(type) {
+	case nil:
+		return true, true, nil
+	case bool:
+		return true, !v, nil
+	case float64:
+		switch v {
+		case 1:
+			return true, false, nil
+		case -1:
+			return false, true, nil
+		case 0:
+			return true, true, nil
+		}
+	case string:
+		switch v {
+		case "yes", "true", "1":
+			return true, false, nil
+		case "-1":
+			return false, true, nil
+		case "no", "false", "0", "":
+			return true, true, nil
+		}
+	}
+	return false, false, fmt.Errorf("unknown %s value %v", oneWayProperty, properties[oneWayProperty])
+}
+
+// snap is the closest position of the network to a point.
+type snap struct {
+	point geometry.Point
+	// link of the position and its distance from the start of the link
+	link  int
+	along float64
+	// distance of the point from the network
+	dist float64
+}
+
+// Snap returns the closest position of the network to the point as a Point Feature, with the distance of the point
+// from the network in the "dist" property, in the units of the network.
+func (g *Graph) Snap(pt geometry.Point) (*feature.Feature, error) {
+	s, err := g.snap(pt)
+	if err != nil {
+		return nil, err
+	}
+	return pointFeature(s.point, map[string]interface{}{"dist": s.dist})
+}
+
+func (g *Graph) snap(pt geometry.Point) (*snap, error) {
+	var best *snap
+	for i, lk := range g.links {
+		a, b := g.nodes[lk.from], g.nodes[lk.to]
+		p, along := a, 0.0
+		if lk.length > 0 {
+			at, err := measurement.AlongTrackDistance(pt, a, b, g.units)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case at >= lk.length:
+				p, along = b, lk.length
+			case at > 0:
+				d, err := measurement.Destination(a, at, measurement.PointBearing(a, b), g.units)
+				if err != nil {
+					return nil, err
+				}
+				p, along = *d, at
+			}
+		}
+		d, err := measurement.PointDistance(pt, p, g.units)
+		if err != nil {
+			return nil, err
+		}
+		if best == nil || d < best.dist {
+			best = &snap{point: p, link: i, along: along, dist: d}
+		}
+	}
+	return best, nil
+}
+
+func lineStrings(g *geometry.Geometry) ([]geometry.LineString, error) {
+	switch g.GeoJSONType {
+	case geojson.LineString:
+		ln, err := g.ToLineString()
+		if err != nil {
+			return nil, err
+		}
+		return []geometry.LineString{*ln}, nil
+	case geojson.MultiLineString:
+		ml, err := g.ToMultiLineString()
+		if err != nil {
+			return nil, err
+		}
+		return ml.Coordinates, nil
+	}
+	return nil, errors.New("geometry must be a LineString or a MultiLineString")
+}
+
+func pointsToCoords(points []geometry.Point) [][]float64 {
+	coords := [][]float64{}
+	for _, p := range points {
+		coords = append(coords, []float64{p.Lng, p.Lat})
+	}
+	return coords
+}
+
+func bbox(points []geometry.Point) []float64 {
+	b := []float64{points[0].Lng, points[0].Lat, points[0].Lng, points[0].Lat}
+	for _, p := range points[1:] {
+		b[0] = math.Min(b[0], p.Lng)
+		b[1] = math.Min(b[1], p.Lat)
+		b[2] = math.Max(b[2], p.Lng)
+		b[3] = math.Max(b[3], p.Lat)
+	}
+	return b
+}
+
+func pointFeature(p geometry.Point, props map[string]interface{}) (*feature.Feature, error) {
+	g := geometry.Geometry{
+		GeoJSONType: geojson.Point,
+		Coordinates: []float64{p.Lng, p.Lat},
+	}
+	return feature.New(g, []float64{p.Lng, p.Lat, p.Lng, p.Lat}, props, "")
+}
+
+func lineFeature(points []geometry.Point, props map[string]interface{}) (*feature.Feature, error) {
+	g := geometry.Geometry{
+		GeoJSONType: geojson.LineString,
+		Coordinates: pointsToCoords(points),
+	}
+	return feature.New(g, bbox(points), props, "")
+}
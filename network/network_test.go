@@ -0,0 +1,117 @@
+package network
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// a grid of streets around a block, the south street is one-way to the east and the diagonal is slow
+const streets = "{ \"type\": \"FeatureCollection\", \"features\": [" +
+	"{ \"type\": \"Feature\", \"properties\": { \"name\": \"south\", \"oneway\": \"yes\", \"time\": 2 }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [0.01, 0], [0.02, 0]] } }," +
+	"{ \"type\": \"Feature\", \"properties\": { \"name\": \"east\", \"time\": 2 }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0.02, 0], [0.02, 0.01]] } }," +
+	"{ \"type\": \"Feature\", \"properties\": { \"name\": \"north\", \"time\": 2 }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0.01], [0.02, 0.01]] } }," +
+	"{ \"type\": \"Feature\", \"properties\": { \"name\": \"west\", \"time\": 1 }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [0, 0.01]] } }," +
+	"{ \"type\": \"Feature\", \"properties\": { \"name\": \"diagonal\", \"time\": 10 }, \"geometry\": { \"type\": \"LineString\", \"coordinates\": [[0, 0], [0.02, 0.01]] } }]}"
+
+func TestRoute(t *testing.T) {
+	fc, err := feature.CollectionFromJSON(streets)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+		return
+	}
+
+	// by length the diagonal is the shortest
+	g, err := New(fc, nil)
+	if err != nil {
+		t.Errorf("New error %v", err)
+		return
+	}
+	f, err := g.Route(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 0.02, Lat: 0.01})
+	if err != nil {
+		t.Errorf("Route error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.GeoJSONType, geojson.LineString)
+	assert.Equal(t, f.Geometry.Coordinates, [][]float64{{0, 0}, {0.02, 0.01}})
+	assert.True(t, math.Abs(f.Properties["length"].(float64)-2.4864) < 1e-3)
+	assert.Equal(t, f.Properties["weight"], f.Properties["length"])
+
+	// by time the streets are faster, the one-way south street is taken to the east only
+	g, err = New(fc, &Options{Units: constants.UnitMeters, WeightProperty: "time", OneWayProperty: "oneway"})
+	if err != nil {
+		t.Errorf("New error %v", err)
+		return
+	}
+	f, err = g.Route(geometry.Point{Lng: 0, Lat: 0}, geometry.Point{Lng: 0.02, Lat: 0.01})
+	if err != nil {
+		t.Errorf("Route error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.Coordinates, [][]float64{{0, 0}, {0.01, 0}, {0.02, 0}, {0.02, 0.01}})
+	assert.True(t, math.Abs(f.Properties["weight"].(float64)-4) < 1e-9)
+
+	f, err = g.Route(geometry.Point{Lng: 0.02, Lat: 0}, geometry.Point{Lng: 0, Lat: 0})
+	if err != nil {
+		t.Errorf("Route error %v", err)
+		return
+	}
+	assert.Equal(t, f.Geometry.Coordinates, [][]float64{{0.02, 0}, {0.02, 0.01}, {0, 0.01}, {0, 0}})
+	assert.True(t, math.Abs(f.Properties["weight"].(float64)-5) < 1e-9)
+
+	// the points are snapped to the network, within a link the route follows its direction
+	f, err = g.Route(geometry.Point{Lng: 0.005, Lat: -0.001}, geometry.Point{Lng: 0.015, Lat: 0.001})
+	if err != nil {
+		t.Errorf("Route error %v", err)
+		return
+	}
+	ln, err := f.ToLineString()
+	if err != nil {
+		t.Errorf("ToLineString error %v", err)
+		return
+	}
+	assert.Equal(t, len(ln.Coordinates), 3)
+	assert.True(t, math.Abs(ln.Coordinates[0].Lng-0.005) < 1e-9 && math.Abs(ln.Coordinates[0].Lat) < 1e-9)
+	assert.Equal(t, ln.Coordinates[1], geometry.Point{Lng: 0.01, Lat: 0})
+	assert.True(t, math.Abs(f.Properties["weight"].(float64)-1) < 1e-6)
+	f, err = g.Route(geometry.Point{Lng: 0.015, Lat: -0.001}, geometry.Point{Lng: 0.005, Lat: 0.001})
+	if err != nil {
+		t.Errorf("Route error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(f.Properties["weight"].(float64)-6) < 1e-6)
+
+	_, err = New(fc.Features[0], nil)
+	assert.True(t, err != nil)
+}
+
+func TestSnap(t *testing.T) {
+	fc, err := feature.CollectionFromJSON(streets)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+		return
+	}
+	g, err := New(fc, &Options{Units: constants.UnitMeters})
+	if err != nil {
+		t.Errorf("New error %v", err)
+		return
+	}
+	f, err := g.Snap(geometry.Point{Lng: -0.001, Lat: 0.005})
+	if err != nil {
+		t.Errorf("Snap error %v", err)
+		return
+	}
+	p, err := f.ToPoint()
+	if err != nil {
+		t.Errorf("ToPoint error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(p.Lng) < 1e-9)
+	assert.True(t, math.Abs(p.Lat-0.005) < 1e-6)
+	assert.True(t, math.Abs(f.Properties["dist"].(float64)-111.19) < 0.01)
+}
@@ -0,0 +1,179 @@
+package network
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// Route returns the shortest route between two points snapped to the closest positions of the network, as a
+// LineString Feature from the snapped start to the snapped end with the cost of the route in the "weight" property and
+// its length in the "length" property, in the units of the network.
+// The route is searched with A* and the great circle distance to the end when the weights are the lengths, with
+// Dijkstra when they come from a property.
+func (g *Graph) Route(start geometry.Point, end geometry.Point) (*feature.Feature, error) {
+	from, err := g.snap(start)
+	if err != nil {
+		return nil, err
+	}
+	to, err := g.snap(end)
+	if err != nil {
+		return nil, err
+	}
+
+	source, target := len(g.nodes), len(g.nodes)+1
+	extra := g.snapEdges(source, from, target, to)
+	var heuristic func(int) (float64, error)
+	if !g.weighted {
+		heuristic = func(i int) (float64, error) {
+			if i == target {
+				return 0, nil
+			}
+			return measurement.PointDistance(g.nodes[i], to.point, g.units)
+		}
+	}
+	s, err := g.search(source, target, extra, math.Inf(1), heuristic)
+	if err != nil {
+		return nil, err
+	}
+	if math.IsInf(s.dist[target], 1) {
+		return nil, errors.New("no route between start and end")
+	}
+
+	points := []geometry.Point{}
+	length := 0.0
+	for i := target; i != -1; i = s.prev[i] {
+		p := to.point
+		switch {
+		case i == source:
+			p = from.point
+		case i < len(g.nodes):
+			p = g.nodes[i]
+		}
+		if len(points) == 0 || points[0] != p {
+			points = append([]geometry.Point{p}, points...)
+		}
+		length += s.length[i]
+	}
+	if len(points) == 1 {
+		// start and end snap to the same position
+		points = append(points, points[0])
+	}
+	return lineFeature(points, map[string]interface{}{"weight": s.dist[target], "length": length})
+}
+
+// snapEdges returns the edges joining a source snapped to the network to the ends of its link, and the ends of the
+// link of a target to the target. The source and the target have the indices after the nodes of the graph.
+func (g *Graph) snapEdges(source int, from *snap, target int, to *snap) map[int][]edge {
+	extra := map[int][]edge{}
+	add := func(i int, e edge) {
+		if !math.IsInf(e.weight, 1) {
+			extra[i] = append(extra[i], e)
+		}
+	}
+	lf := g.links[from.link]
+	add(source, edge{to: lf.from, weight: portion(lf.backward, from.along, lf.length), length: from.along})
+	add(source, edge{to: lf.to, weight: portion(lf.forward, lf.length-from.along, lf.length), length: lf.length - from.along})
+	if target >= 0 {
+		lt := g.links[to.link]
+		add(lt.from, edge{to: target, weight: portion(lt.forward, to.along, lt.length), length: to.along})
+		add(lt.to, edge{to: target, weight: portion(lt.backward, lt.length-to.along, lt.length), length: lt.length - to.along})
+		if from.link == to.link {
+			if to.along >= from.along {
+				add(source, edge{to: target, weight: portion(lf.forward, to.along-from.along, lf.length), length: to.along - from.along})
+			} else {
+				add(source, edge{to: target, weight: portion(lf.backward, from.along-to.along, lf.length), length: from.along - to.along})
+			}
+		}
+	}
+	return extra
+}
+
+// portion returns the weight of a part of a link, a direction that can't be traversed stays infinite.
+func portion(weight float64, part float64, length float64) float64 {
+	if math.IsInf(weight, 1) || length == 0 {
+		return weight
+	}
+	return weight * part / length
+}
+
+// search is the result of a shortest path search, indexed by node: the cost from the source, the previous node of the
+// path or -1 and the length of the edge from the previous node.
+type search struct {
+	dist   []float64
+	prev   []int
+	length []float64
+}
+
+// search runs Dijkstra, or A* with an heuristic, from the source until the target is settled or, without a target
+// (-1), until the nodes within the cutoff cost are settled. The extra edges leave the nodes of the graph and the two
+// virtual nodes after them.
+func (g *Graph) search(source int, target int, extra map[int][]edge, cutoff float64, heuristic func(int) (float64, error)) (*search, error) {
+	n := len(g.nodes) + 2
+	s := &search{dist: make([]float64, n), prev: make([]int, n), length: make([]float64, n)}
+	for i := range s.dist {
+		s.dist[i] = math.Inf(1)
+		s.prev[i] = -1
+	}
+	done := make([]bool, n)
+	s.dist[source] = 0
+
+	q := &queue{{node: source}}
+	for q.Len() > 0 {
+		u := heap.Pop(q).(item).node
+		if done[u] {
+			continue
+		}
+		done[u] = true
+		if u == target {
+			break
+		}
+		edges := extra[u]
+		if u < len(g.nodes) {
+			edges = append(g.adjacent[u][:len(g.adjacent[u]):len(g.adjacent[u])], edges...)
+		}
+		for _, e := range edges {
+			d := s.dist[u] + e.weight
+			if done[e.to] || d >= s.dist[e.to] || d > cutoff {
+				continue
+			}
+			s.dist[e.to] = d
+			s.prev[e.to] = u
+			s.length[e.to] = e.length
+			priority := d
+			if heuristic != nil {
+				h, err := heuristic(e.to)
+				if err != nil {
+					return nil, err
+				}
+				priority += h
+			}
+			heap.Push(q, item{node: e.to, priority: priority})
+		}
+	}
+	return s, nil
+}
+
+// item is a node of the search queue with its priority.
+type item struct {
+	node     int
+	priority float64
+}
+
+// queue is a min-heap of nodes by priority, a node is pushed again when its cost decreases.
+type queue []item
+
+func (q queue) Len() int            { return len(q) }
+func (q queue) Less(i, j int) bool  { return q[i].priority < q[j].priority }
+func (q queue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x interface{}) { *q = append(*q, x.(item)) }
+func (q *queue) Pop() interface{} {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
@@ -0,0 +1,189 @@
+package network
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// ServiceAreaOptions contains options for the ServiceArea function
+type ServiceAreaOptions struct {
+	// MaxEdge is the longest edge of the triangles of the concave hulls in units of the network, twice the longest
+	// reachable link by default
+	MaxEdge float64 `json:"maxEdge,omitempty"`
+}
+
+// interval is a part of a link between two distances from its start.
+type interval struct {
+	start float64
+	end   float64
+}
+
+// ServiceArea returns the parts of the network reachable from the origin snapped to the network within every cutoff
+// weight, as MultiLineString Features, and the concave hulls of their vertices as Polygon or MultiPolygon Features.
+// Both have the cutoff in the "cutoff" property, the cutoffs reaching no link or too few points for a hull have no
+// Feature. With the speed property of the graph the cutoffs are travel times in minutes, like 5, 10 and 15.
+// The hull is the union of the Delaunay triangles of the vertices with edges not longer than the MaxEdge option.
+func (g *Graph) ServiceArea(origin geometry.Point, cutoffs []float64, options *ServiceAreaOptions) (*feature.Collection, *feature.Collection, error) {
+	if options == nil {
+		options = &ServiceAreaOptions{}
+	}
+	if len(cutoffs) == 0 {
+		return nil, nil, errors.New("at least one cutoff is required")
+	}
+	maxCutoff := 0.0
+	for _, c := range cutoffs {
+		if c <= 0 {
+			return nil, nil, errors.New("cutoffs must be greater than 0")
+		}
+		maxCutoff = math.Max(maxCutoff, c)
+	}
+
+	from, err := g.snap(origin)
+	if err != nil {
+		return nil, nil, err
+	}
+	source := len(g.nodes)
+	s, err := g.search(source, -1, g.snapEdges(source, from, -1, nil), maxCutoff, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	networks := []feature.Feature{}
+	areas := []feature.Feature{}
+	for _, c := range cutoffs {
+		pieces := [][]geometry.Point{}
+		points := []geometry.Point{from.point}
+		longest := 0.0
+		for i := range g.links {
+			for _, iv := range g.reach(i, s, from, c) {
+				piece, err := g.piece(i, iv)
+				if err != nil {
+					return nil, nil, err
+				}
+				pieces = append(pieces, piece)
+				points = append(points, piece...)
+				longest = math.Max(longest, iv.end-iv.start)
+			}
+		}
+		if len(pieces) == 0 {
+			continue
+		}
+
+		coords := [][][]float64{}
+		for _, p := range pieces {
+			coords = append(coords, pointsToCoords(p))
+		}
+		n, err := feature.New(geometry.Geometry{
+			GeoJSONType: geojson.MultiLineString,
+			Coordinates: coords,
+		}, bbox(points), map[string]interface{}{"cutoff": c}, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		networks = append(networks, *n)
+
+		maxEdge := options.MaxEdge
+		if maxEdge <= 0 {
+			maxEdge = 2 * longest
+		}
+		polygons, err := concaveHull(points, maxEdge, g.units)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(polygons) == 0 {
+			continue
+		}
+		a, err := feature.New(polygonGeometry(polygons), bbox(points), map[string]interface{}{"cutoff": c}, "")
+		if err != nil {
+			return nil, nil, err
+		}
+		areas = append(areas, *a)
+	}
+
+	nc, err := feature.NewFeatureCollection(networks)
+	if err != nil {
+		return nil, nil, err
+	}
+	ac, err := feature.NewFeatureCollection(areas)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nc, ac, nil
+}
+
+// reach returns the parts of a link reachable within the cutoff, from its ends and from the origin on the link.
+func (g *Graph) reach(i int, s *search, from *snap, cutoff float64) []interval {
+	lk := g.links[i]
+	// along returns the length of the link covered with the remaining cost in a direction
+	along := func(weight float64, remaining float64) float64 {
+		if weight == 0 {
+			return lk.length
+		}
+		return math.Min(lk.length, remaining/weight*lk.length)
+	}
+
+	ivs := []interval{}
+	if d := s.dist[lk.from]; !math.IsInf(lk.forward, 1) && d < cutoff {
+		ivs = append(ivs, interval{0, along(lk.forward, cutoff-d)})
+	}
+	if d := s.dist[lk.to]; !math.IsInf(lk.backward, 1) && d < cutoff {
+		ivs = append(ivs, interval{lk.length - along(lk.backward, cutoff-d), lk.length})
+	}
+	if i == from.link {
+		if !math.IsInf(lk.forward, 1) {
+			ivs = append(ivs, interval{from.along, math.Min(lk.length, from.along+along(lk.forward, cutoff))})
+		}
+		if !math.IsInf(lk.backward, 1) {
+			ivs = append(ivs, interval{math.Max(0, from.along-along(lk.backward, cutoff)), from.along})
+		}
+	}
+
+	// merge the overlapping parts
+	sort.Slice(ivs, func(a, b int) bool { return ivs[a].start < ivs[b].start })
+	merged := []interval{}
+	for _, iv := range ivs {
+		if iv.end <= iv.start {
+			continue
+		}
+		if len(merged) > 0 && iv.start <= merged[len(merged)-1].end {
+			merged[len(merged)-1].end = math.Max(merged[len(merged)-1].end, iv.end)
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return merged
+}
+
+// piece returns the positions of a part of a link.
+func (g *Graph) piece(i int, iv interval) ([]geometry.Point, error) {
+	lk := g.links[i]
+	a, b := g.nodes[lk.from], g.nodes[lk.to]
+	position := func(d float64) (geometry.Point, error) {
+		switch {
+		case d <= 0:
+			return a, nil
+		case d >= lk.length:
+			return b, nil
+		}
+		p, err := measurement.Destination(a, d, measurement.PointBearing(a, b), g.units)
+		if err != nil {
+			return geometry.Point{}, err
+		}
+		return *p, nil
+	}
+	start, err := position(iv.start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := position(iv.end)
+	if err != nil {
+		return nil, err
+	}
+	return []geometry.Point{start, end}, nil
+}
@@ -0,0 +1,127 @@
+package network
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// grid returns the streets of a grid of 3x3 crossings 0.01 degrees apart, at 60 km/h.
+func grid(t *testing.T) *feature.Collection {
+	features := []feature.Feature{}
+	for i := 0; i < 3; i++ {
+		for _, coords := range [][][]float64{
+			{{0, float64(i) * 0.01}, {0.01, float64(i) * 0.01}, {0.02, float64(i) * 0.01}},
+			{{float64(i) * 0.01, 0}, {float64(i) * 0.01, 0.01}, {float64(i) * 0.01, 0.02}},
+		} {
+			f, err := feature.New(geometry.Geometry{GeoJSONType: geojson.LineString, Coordinates: coords}, nil, map[string]interface{}{"speed": 60}, "")
+			if err != nil {
+				t.Fatalf("feature error %v", err)
+			}
+			features = append(features, *f)
+		}
+	}
+	fc, err := feature.NewFeatureCollection(features)
+	if err != nil {
+		t.Fatalf("NewFeatureCollection error %v", err)
+	}
+	return fc
+}
+
+func TestServiceArea(t *testing.T) {
+	g, err := New(grid(t), &Options{Units: constants.UnitKilometers, SpeedProperty: "speed"})
+	if err != nil {
+		t.Errorf("New error %v", err)
+		return
+	}
+	// a link is 1.11 km long, 1.11 minutes at 60 km/h
+	origin := geometry.Point{Lng: 0.01, Lat: 0.01}
+	networks, areas, err := g.ServiceArea(origin, []float64{0.5, 1.5, 3}, nil)
+	if err != nil {
+		t.Errorf("ServiceArea error %v", err)
+		return
+	}
+	assert.Equal(t, len(networks.Features), 3)
+	assert.Equal(t, len(areas.Features), 3)
+
+	lengths := []float64{}
+	for i, n := range networks.Features {
+		assert.Equal(t, n.Geometry.GeoJSONType, geojson.MultiLineString)
+		ml, err := n.ToMultiLineString()
+		if err != nil {
+			t.Errorf("ToMultiLineString error %v", err)
+			return
+		}
+		l, err := measurement.Length(*ml, constants.UnitKilometers)
+		if err != nil {
+			t.Errorf("Length error %v", err)
+			return
+		}
+		lengths = append(lengths, l)
+		assert.Equal(t, n.Properties["cutoff"], []float64{0.5, 1.5, 3}[i])
+	}
+	// half a kilometer in the 4 directions, the 4 links from the origin and 0.39 km more on 8 links, the whole grid
+	assert.True(t, math.Abs(lengths[0]-2) < 1e-3)
+	assert.True(t, math.Abs(lengths[1]-(4*1.11195+8*0.38805)) < 1e-3)
+	assert.True(t, math.Abs(lengths[2]-12*1.11195) < 1e-3)
+
+	// the hulls grow with the cutoff and contain the origin
+	sizes := []float64{}
+	for _, a := range areas.Features {
+		assert.Equal(t, a.Geometry.GeoJSONType, geojson.Polygon)
+		poly, err := a.ToPolygon()
+		if err != nil {
+			t.Errorf("ToPolygon error %v", err)
+			return
+		}
+		ring := poly.Coordinates[0].Coordinates
+		assert.True(t, pointInRing(geometry.Point{Lng: 0.0101, Lat: 0.0101}, ring))
+		sizes = append(sizes, signedArea(ring))
+	}
+	assert.True(t, sizes[0] < sizes[1] && sizes[1] < sizes[2])
+	assert.True(t, math.Abs(sizes[2]-0.0004) < 1e-12)
+
+	// a maximum edge shorter than the block diagonals keeps no triangle
+	_, areas, err = g.ServiceArea(origin, []float64{3}, &ServiceAreaOptions{MaxEdge: 1.2})
+	if err != nil {
+		t.Errorf("ServiceArea error %v", err)
+		return
+	}
+	assert.Equal(t, len(areas.Features), 0)
+
+	_, _, err = g.ServiceArea(origin, []float64{0}, nil)
+	assert.True(t, err != nil)
+}
+
+func TestConcaveHull(t *testing.T) {
+	// an L shape, only the triangle of the inner corner covers the notch with a short maximum edge
+	points := []geometry.Point{}
+	for x := 0; x <= 4; x++ {
+		for y := 0; y <= 4; y++ {
+			if x <= 1 || y <= 1 {
+				points = append(points, geometry.Point{Lng: float64(x) * 0.01, Lat: float64(y) * 0.01})
+			}
+		}
+	}
+	polygons, err := concaveHull(points, 1.6, constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("concaveHull error %v", err)
+		return
+	}
+	assert.Equal(t, len(polygons), 1)
+	assert.Equal(t, len(polygons[0]), 1)
+	assert.True(t, math.Abs(signedArea(polygons[0][0])-0.00075) < 1e-12)
+
+	polygons, err = concaveHull(points, math.Inf(1), constants.UnitKilometers)
+	if err != nil {
+		t.Errorf("concaveHull error %v", err)
+		return
+	}
+	assert.True(t, math.Abs(signedArea(polygons[0][0])-0.0016+0.00045) < 1e-12)
+}
@@ -0,0 +1,317 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/et-soft/turf-go/constants"
+	"github.com/et-soft/turf-go/measurement"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+	"github.com/tomchavakis/geojson/geometry"
+)
+
+// TourOptions contains options for the OptimizeVisitOrder function
+type TourOptions struct {
+	// Units of the distances between the points, kilometers by default
+	Units string `json:"units,omitempty"`
+	// Matrix is the distance from every point to every other point, in the order of the features, instead of the great
+	// circle distances. It can be asymmetric, like travel times on a network.
+	Matrix [][]float64 `json:"matrix,omitempty"`
+	// FixedStart keeps the first point at the start of the tour
+	FixedStart bool `json:"fixedStart,omitempty"`
+	// FixedEnd keeps the last point at the end of the tour
+	FixedEnd bool `json:"fixedEnd,omitempty"`
+	// RoundTrip returns to the start at the end of the tour
+	RoundTrip bool `json:"roundTrip,omitempty"`
+}
+
+// OptimizeVisitOrder orders a FeatureCollection of Point Features into a short tour visiting every point once.
+// The tour is built from the nearest neighbour of every point, from every possible start when the start is not fixed,
+// and improved with 2-opt segment reversals and Or-opt moves of 1 to 3 consecutive points until no move shortens it.
+// It returns the points in the visit order with their original index in the "index" property and the tour as a
+// LineString Feature with its total distance in the "distance" property.
+func OptimizeVisitOrder(points interface{}, options *TourOptions) (*feature.Collection, *feature.Feature, error) {
+	if options == nil {
+		options = &TourOptions{}
+	}
+	var features []feature.Feature
+	switch gtp := points.(type) {
+	case feature.Collection:
+		features = gtp.Features
+	case *feature.Collection:
+		features = gtp.Features
+	default:
+		return nil, nil, errors.New("a FeatureCollection is required")
+	}
+	if len(features) == 0 {
+		return nil, nil, errors.New("at least one point is required")
+	}
+	if options.RoundTrip && options.FixedEnd {
+		return nil, nil, errors.New("a round trip ends at its start")
+	}
+
+	positions := []geometry.Point{}
+	for i := range features {
+		if features[i].Geometry.GeoJSONType != geojson.Point {
+			return nil, nil, errors.New("features must be Points")
+		}
+		p, err := features[i].ToPoint()
+		if err != nil {
+			return nil, nil, err
+		}
+		positions = append(positions, *p)
+	}
+	matrix, err := distanceMatrix(positions, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := &tour{matrix: matrix, roundTrip: options.RoundTrip, lo: 0, hi: len(positions) - 1}
+	if options.FixedStart {
+		t.lo = 1
+	}
+	if options.FixedEnd {
+		t.hi = len(positions) - 2
+	}
+	order := t.nearestNeighbour(options.FixedStart, options.FixedEnd)
+	order = t.improve(order)
+
+	ordered := []feature.Feature{}
+	path := []geometry.Point{}
+	for _, i := range order {
+		props := map[string]interface{}{}
+		for k, v := range features[i].Properties {
+			props[k] = v
+		}
+		props["index"] = i
+		f, err := feature.New(features[i].Geometry, features[i].Bbox, props, features[i].ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		ordered = append(ordered, *f)
+		path = append(path, positions[i])
+	}
+	if options.RoundTrip || len(path) == 1 {
+		path = append(path, path[0])
+	}
+	fc, err := feature.NewFeatureCollection(ordered)
+	if err != nil {
+		return nil, nil, err
+	}
+	line, err := lineFeature(path, map[string]interface{}{"distance": t.cost(order)})
+	if err != nil {
+		return nil, nil, err
+	}
+	return fc, line, nil
+}
+
+// distanceMatrix returns the matrix of the options, checked, or the great circle distances between the points.
+func distanceMatrix(points []geometry.Point, options *TourOptions) ([][]float64, error) {
+	n := len(points)
+	if options.Matrix != nil {
+		if len(options.Matrix) != n {
+			return nil, fmt.Errorf("the matrix must have %d rows", n)
+		}
+		for _, row := range options.Matrix {
+			if len(row) != n {
+				return nil, fmt.Errorf("the matrix must have %d columns", n)
+			}
+		}
+		return options.Matrix, nil
+	}
+
+	units := options.Units
+	if units == "" {
+		units = constants.UnitKilometers
+	}
+	matrix := make([][]float64, n)
+	for i := range matrix {
+		matrix[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			d, err := measurement.PointDistance(points[i], points[j], units)
+			if err != nil {
+				return nil, err
+			}
+			matrix[i][j], matrix[j][i] = d, d
+		}
+	}
+	return matrix, nil
+}
+
+// tour is an order of visit of the points, the positions from lo to hi can be moved.
+type tour struct {
+	matrix    [][]float64
+	roundTrip bool
+	lo        int
+	hi        int
+}
+
+// cost returns the distance of an order of visit.
+func (t *tour) cost(order []int) float64 {
+	total := 0.0
+	for i := 1; i < len(order); i++ {
+		total += t.matrix[order[i-1]][order[i]]
+	}
+	if t.roundTrip && len(order) > 1 {
+		total += t.matrix[order[len(order)-1]][order[0]]
+	}
+	return total
+}
+
+// nearestNeighbour returns the shortest of the orders going to the nearest unvisited point from every allowed start.
+func (t *tour) nearestNeighbour(fixedStart bool, fixedEnd bool) []int {
+	n := len(t.matrix)
+	starts := []int{}
+	for s := 0; s < n; s++ {
+		if (fixedStart && s != 0) || (fixedEnd && s == n-1 && n > 1) {
+			continue
+		}
+		starts = append(starts, s)
+	}
+
+	var best []int
+	bestCost := 0.0
+	for _, s := range starts {
+		visited := make([]bool, n)
+		visited[s] = true
+		order := []int{s}
+		if fixedEnd {
+			visited[n-1] = true
+		}
+		for len(order) < n {
+			last := order[len(order)-1]
+			next := -1
+			for j := 0; j < n; j++ {
+				if !visited[j] && (next == -1 || t.matrix[last][j] < t.matrix[last][next]) {
+					next = j
+				}
+			}
+			if next == -1 {
+				// only the fixed end is left
+				next = n - 1
+			}
+			visited[next] = true
+			order = append(order, next)
+		}
+		if c := t.cost(order); best == nil || c < bestCost {
+			best, bestCost = order, c
+		}
+	}
+	return best
+}
+
+// improve applies the first 2-opt or Or-opt move shortening the tour until none does.
+// The moves are evaluated from the few edges they replace, the reversed sections of the 2-opt moves from prefix sums
+// of the edges in both directions, so a pass over all the moves takes O(n²).
+func (t *tour) improve(order []int) []int {
+	const epsilon = 1e-12
+	n := len(order)
+	for improved := true; improved; {
+		improved = false
+		// forward[k] and backward[k] are the costs of the path from 0 to k in the order and in the reverse order
+		forward := make([]float64, n)
+		backward := make([]float64, n)
+		for k := 1; k < n; k++ {
+			forward[k] = forward[k-1] + t.matrix[order[k-1]][order[k]]
+			backward[k] = backward[k-1] + t.matrix[order[k]][order[k-1]]
+		}
+
+		// 2-opt, reverse the points from i to j
+		for i := t.lo; i < t.hi && !improved; i++ {
+			for j := i + 1; j <= t.hi && !improved; j++ {
+				var delta float64
+				if t.roundTrip && i == 0 && j == n-1 {
+					// the whole round trip in the reverse direction
+					delta = backward[j] + t.matrix[order[0]][order[j]] - forward[j] - t.matrix[order[j]][order[0]]
+				} else {
+					delta = backward[j] - backward[i] - forward[j] + forward[i]
+					if p, ok := t.before(order, i); ok {
+						delta += t.matrix[p][order[j]] - t.matrix[p][order[i]]
+					}
+					if s, ok := t.after(order, j); ok {
+						delta += t.matrix[order[i]][s] - t.matrix[order[j]][s]
+					}
+				}
+				if delta < -epsilon {
+					for a, b := i, j; a < b; a, b = a+1, b-1 {
+						order[a], order[b] = order[b], order[a]
+					}
+					improved = true
+				}
+			}
+		}
+		// Or-opt, move 1 to 3 consecutive points from i to another position
+		for length := 1; length <= 3 && !improved; length++ {
+			for i := t.lo; i+length-1 <= t.hi && !improved; i++ {
+				first, last := order[i], order[i+length-1]
+				rest := append(append([]int{}, order[:i]...), order[i+length:]...)
+				// taking the points out joins their neighbours
+				removal := 0.0
+				p, hasBefore := t.before(order, i)
+				s, hasAfter := t.after(order, i+length-1)
+				if hasBefore {
+					removal -= t.matrix[p][first]
+				}
+				if hasAfter {
+					removal -= t.matrix[last][s]
+				}
+				if hasBefore && hasAfter {
+					removal += t.matrix[p][s]
+				}
+				for k := t.lo; k <= t.hi-length+1 && !improved; k++ {
+					if k == i {
+						continue
+					}
+					// inserting the points before rest[k] splits the edge between rest[k-1] and rest[k]
+					delta := removal
+					p, hasBefore := t.before(rest, k)
+					s, hasAfter := t.after(rest, k-1)
+					if k == 0 && len(rest) > 0 {
+						s, hasAfter = rest[0], true
+					}
+					if hasBefore {
+						delta += t.matrix[p][first]
+					}
+					if hasAfter {
+						delta += t.matrix[last][s]
+					}
+					if hasBefore && hasAfter {
+						delta -= t.matrix[p][s]
+					}
+					if delta < -epsilon {
+						order = append(append(append([]int{}, rest[:k]...), order[i:i+length]...), rest[k:]...)
+						improved = true
+					}
+				}
+			}
+		}
+	}
+	return order
+}
+
+// before returns the point visited before the position k of an order, the last one of a round trip for the first
+// position.
+func (t *tour) before(order []int, k int) (int, bool) {
+	switch {
+	case k > 0:
+		return order[k-1], true
+	case t.roundTrip && len(order) > 0:
+		return order[len(order)-1], true
+	}
+	return 0, false
+}
+
+// after returns the point visited after the position k of an order, the first one of a round trip for the last
+// position.
+func (t *tour) after(order []int, k int) (int, bool) {
+	switch {
+	case k < len(order)-1:
+		return order[k+1], true
+	case t.roundTrip && len(order) > 0:
+		return order[0], true
+	}
+	return 0, false
+}
@@ -0,0 +1,132 @@
+package network
+
+import (
+	"math"
+	"testing"
+
+	"github.com/et-soft/turf-go/assert"
+	"github.com/tomchavakis/geojson"
+	"github.com/tomchavakis/geojson/feature"
+)
+
+// stops along a street in a shuffled order
+const stops = "{ \"type\": \"FeatureCollection\", \"features\": [" +
+	"{ \"type\": \"Feature\", \"properties\": { \"name\": \"depot\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0, 0] } }," +
+	"{ \"type\": \"Feature\", \"properties\": { \"name\": \"c\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0.03, 0] } }," +
+	"{ \"type\": \"Feature\", \"properties\": { \"name\": \"a\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0.01, 0] } }," +
+	"{ \"type\": \"Feature\", \"properties\": { \"name\": \"d\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0.04, 0] } }," +
+	"{ \"type\": \"Feature\", \"properties\": { \"name\": \"b\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0.02, 0] } }," +
+	"{ \"type\": \"Feature\", \"properties\": { \"name\": \"office\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0.025, 0] } }]}"
+
+func names(fc *feature.Collection) []string {
+	result := []string{}
+	for _, f := range fc.Features {
+		result = append(result, f.Properties["name"].(string))
+	}
+	return result
+}
+
+func TestOptimizeVisitOrder(t *testing.T) {
+	fc, err := feature.CollectionFromJSON(stops)
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+		return
+	}
+
+	ordered, line, err := OptimizeVisitOrder(fc, &TourOptions{FixedStart: true})
+	if err != nil {
+		t.Errorf("OptimizeVisitOrder error %v", err)
+		return
+	}
+	assert.Equal(t, names(ordered), []string{"depot", "a", "b", "office", "c", "d"})
+	assert.Equal(t, ordered.Features[1].Properties["index"], 2)
+	assert.Equal(t, line.Geometry.GeoJSONType, geojson.LineString)
+	assert.Equal(t, len(line.Geometry.Coordinates.([][]float64)), 6)
+	assert.True(t, math.Abs(line.Properties["distance"].(float64)-4*1.11195) < 1e-3)
+
+	// the tour ends at the office
+	ordered, line, err = OptimizeVisitOrder(fc, &TourOptions{FixedStart: true, FixedEnd: true})
+	if err != nil {
+		t.Errorf("OptimizeVisitOrder error %v", err)
+		return
+	}
+	assert.Equal(t, names(ordered), []string{"depot", "a", "b", "c", "d", "office"})
+	assert.True(t, math.Abs(line.Properties["distance"].(float64)-5.5*1.11195) < 1e-3)
+
+	// the round trip comes back to the depot
+	ordered, line, err = OptimizeVisitOrder(fc, &TourOptions{FixedStart: true, RoundTrip: true})
+	if err != nil {
+		t.Errorf("OptimizeVisitOrder error %v", err)
+		return
+	}
+	assert.Equal(t, ordered.Features[0].Properties["name"], "depot")
+	coords := line.Geometry.Coordinates.([][]float64)
+	assert.Equal(t, len(coords), 7)
+	assert.Equal(t, coords[0], coords[6])
+	assert.True(t, math.Abs(line.Properties["distance"].(float64)-8*1.11195) < 1e-3)
+
+	_, _, err = OptimizeVisitOrder(fc, &TourOptions{FixedEnd: true, RoundTrip: true})
+	assert.True(t, err != nil)
+
+	// the nearest neighbour goes east first and crosses back, the 2-opt reversal starts west
+	fc, err = feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"depot\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0, 0] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"east\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0.01, 0] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"west\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [-0.015, 0] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"far east\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0.03, 0] } }]}")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+		return
+	}
+	ordered, line, err = OptimizeVisitOrder(fc, &TourOptions{FixedStart: true})
+	if err != nil {
+		t.Errorf("OptimizeVisitOrder error %v", err)
+		return
+	}
+	assert.Equal(t, names(ordered), []string{"depot", "west", "east", "far east"})
+	assert.True(t, math.Abs(line.Properties["distance"].(float64)-6*1.11195) < 1e-3)
+}
+
+func TestOptimizeVisitOrderMatrix(t *testing.T) {
+	fc, err := feature.CollectionFromJSON("{ \"type\": \"FeatureCollection\", \"features\": [" +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"depot\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0, 0] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"a\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [0, 1] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"b\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [1, 1] } }," +
+		"{ \"type\": \"Feature\", \"properties\": { \"name\": \"c\" }, \"geometry\": { \"type\": \"Point\", \"coordinates\": [1, 0] } }]}")
+	if err != nil {
+		t.Errorf("CollectionFromJSON error %v", err)
+		return
+	}
+
+	// one-way streets make the tour clockwise
+	matrix := [][]float64{
+		{0, 1, 10, 10},
+		{10, 0, 1, 10},
+		{10, 10, 0, 1},
+		{1, 10, 10, 0},
+	}
+	ordered, line, err := OptimizeVisitOrder(fc, &TourOptions{Matrix: matrix, FixedStart: true, RoundTrip: true})
+	if err != nil {
+		t.Errorf("OptimizeVisitOrder error %v", err)
+		return
+	}
+	assert.Equal(t, names(ordered), []string{"depot", "a", "b", "c"})
+	assert.Equal(t, line.Properties["distance"], 4.0)
+
+	// without a fixed start the cheapest start is found
+	matrix = [][]float64{
+		{0, 1, 2, 3},
+		{10, 0, 1, 2},
+		{10, 10, 0, 1},
+		{10, 10, 10, 0},
+	}
+	ordered, _, err = OptimizeVisitOrder(fc, &TourOptions{Matrix: matrix})
+	if err != nil {
+		t.Errorf("OptimizeVisitOrder error %v", err)
+		return
+	}
+	assert.Equal(t, names(ordered), []string{"depot", "a", "b", "c"})
+
+	_, _, err = OptimizeVisitOrder(fc, &TourOptions{Matrix: matrix[:3]})
+	assert.True(t, err != nil)
+}
@@ -6,10 +6,10 @@ import (
 	"math"
 	"math/rand"
 
+	"github.com/et-soft/turf-go/internal/common"
 	"github.com/tomchavakis/geojson"
 	"github.com/tomchavakis/geojson/feature"
 	"github.com/tomchavakis/geojson/geometry"
-	"github.com/tomchavakis/turf-go/internal/common"
 )
 
 // LineStringOptions ...
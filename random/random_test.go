@@ -3,11 +3,11 @@ package random
 import (
 	"testing"
 
+	"github.com/et-soft/turf-go"
+	"github.com/et-soft/turf-go/assert"
+	"github.com/et-soft/turf-go/internal/common"
 	"github.com/tomchavakis/geojson"
 	"github.com/tomchavakis/geojson/feature"
-	"github.com/tomchavakis/turf-go"
-	"github.com/tomchavakis/turf-go/assert"
-	"github.com/tomchavakis/turf-go/internal/common"
 )
 
 func TestRandomPosition(t *testing.T) {